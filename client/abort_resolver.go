@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+// AbortResolver fills the reason name and description of Move aborts from the error map the compiler stores
+// in the metadata of the aborting module. The error maps are not part of 0x1::code::PackageRegistry, which only
+// lists the modules of each package, so they are read from the module bytecode and cached per module.
+type AbortResolver struct {
+	client Accounts
+
+	mu        sync.Mutex
+	errorMaps map[string]map[uint64]models.ErrorDescription
+}
+
+// NewAbortResolver creates an AbortResolver reading the modules with client.
+func NewAbortResolver(client Accounts) *AbortResolver {
+	return &AbortResolver{
+		client:    client,
+		errorMaps: make(map[string]map[uint64]models.ErrorDescription),
+	}
+}
+
+// Resolve returns status with ReasonName and Description filled from the error map of its module. Statuses
+// other than Move aborts in a module, and reasons missing from the error map, are returned unchanged.
+func (r *AbortResolver) Resolve(ctx context.Context, status models.VMStatus) (models.VMStatus, error) {
+	if status.Kind != models.VMStatusMoveAbort || status.ModuleAddress == "" {
+		return status, nil
+	}
+
+	errorMap, err := r.errorMap(ctx, status.ModuleAddress, status.ModuleName)
+	if err != nil {
+		return status, err
+	}
+	if desc, ok := errorMap[status.Reason]; ok {
+		status.ReasonName = desc.CodeName
+		status.Description = desc.CodeDescription
+	}
+	return status, nil
+}
+
+func (r *AbortResolver) errorMap(ctx context.Context, address, module string) (map[uint64]models.ErrorDescription, error) {
+	key := address + "::" + module
+
+	r.mu.Lock()
+	errorMap, ok := r.errorMaps[key]
+	r.mu.Unlock()
+	if ok {
+		return errorMap, nil
+	}
+
+	m, err := r.client.GetModuleByModuleID(ctx, address, module)
+	if err != nil {
+		return nil, err
+	}
+	bytecode, err := hex.DecodeString(strings.TrimPrefix(m.Bytecode, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("hex.DecodeString error: %w", err)
+	}
+	errorMap, err = models.ParseModuleErrorMap(bytecode)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.errorMaps[key] = errorMap
+	r.mu.Unlock()
+	return errorMap, nil
+}
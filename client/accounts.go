@@ -7,13 +7,15 @@ import (
 )
 
 type Accounts interface {
-	GetAccount(ctx context.Context, address string, opts ...interface{}) (*AccountInfo, error)
-	GetAccountResources(ctx context.Context, address string, opts ...interface{}) ([]AccountResource, error)
-	GetResourceByAccountAddressAndResourceType(ctx context.Context, address, resourceType string, opts ...interface{}) (*AccountResource, error)
-	GetAccountModules(ctx context.Context, address string, opts ...interface{}) ([]AccountModule, error)
-	GetModuleByModuleID(ctx context.Context, address, moduleID string, opts ...interface{}) (*AccountModule, error)
+	GetAccount(ctx context.Context, address string, opts ...RequestOption) (*AccountInfo, error)
+	GetAccountResources(ctx context.Context, address string, opts ...RequestOption) ([]AccountResource, error)
+	GetAccountResourcesPage(ctx context.Context, address, start string, limit uint64, opts ...RequestOption) ([]AccountResource, string, error)
+	GetResourceByAccountAddressAndResourceType(ctx context.Context, address, resourceType string, opts ...RequestOption) (*AccountResource, error)
+	GetAccountModules(ctx context.Context, address string, opts ...RequestOption) ([]AccountModule, error)
+	GetAccountModulesPage(ctx context.Context, address, start string, limit uint64, opts ...RequestOption) ([]AccountModule, string, error)
+	GetModuleByModuleID(ctx context.Context, address, moduleID string, opts ...RequestOption) (*AccountModule, error)
 
-	GetResourceWithCustomType(ctx context.Context, address, resourceType string, resp interface{}, opts ...interface{}) error
+	GetResourceWithCustomType(ctx context.Context, address, resourceType string, resp interface{}, opts ...RequestOption) error
 }
 
 type AccountsImpl struct {
@@ -25,9 +27,9 @@ type AccountInfo struct {
 	AuthenticationKey string `json:"authentication_key"`
 }
 
-func (impl AccountsImpl) GetAccount(ctx context.Context, address string, opts ...interface{}) (*AccountInfo, error) {
+func (impl AccountsImpl) GetAccount(ctx context.Context, address string, opts ...RequestOption) (*AccountInfo, error) {
 	var rspJSON AccountInfo
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetAccount", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s", address),
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
@@ -86,9 +88,9 @@ type TokenStoreResource struct {
 	MutateTokenPropertyEvents EventHandle `json:"mutate_token_property_events"`
 }
 
-func (impl AccountsImpl) GetAccountResources(ctx context.Context, address string, opts ...interface{}) ([]AccountResource, error) {
+func (impl AccountsImpl) GetAccountResources(ctx context.Context, address string, opts ...RequestOption) ([]AccountResource, error) {
 	var rspJSON []AccountResource
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetAccountResources", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/resources", address),
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
@@ -98,9 +100,26 @@ func (impl AccountsImpl) GetAccountResources(ctx context.Context, address string
 	return rspJSON, nil
 }
 
-func (impl AccountsImpl) GetResourceByAccountAddressAndResourceType(ctx context.Context, address, resourceType string, opts ...interface{}) (*AccountResource, error) {
+// GetAccountResourcesPage returns a page of at most limit resources starting at the cursor start, along with
+// the cursor of the next page, which is empty on the last page. An empty start reads the first page and a zero
+// limit uses the node default.
+func (impl AccountsImpl) GetAccountResourcesPage(ctx context.Context, address, start string, limit uint64, opts ...RequestOption) ([]AccountResource, string, error) {
+	var rspJSON []AccountResource
+	var header ResponseHeader
+	err := impl.Base.request(ctx, "GetAccountResourcesPage", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/resources", address),
+		nil, &rspJSON, pageQuery(start, limit), requestOptions(append(opts[:len(opts):len(opts)], &header)...))
+	copyResponseHeader(opts, header)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rspJSON, header.AptosCursor, nil
+}
+
+func (impl AccountsImpl) GetResourceByAccountAddressAndResourceType(ctx context.Context, address, resourceType string, opts ...RequestOption) (*AccountResource, error) {
 	var rspJSON AccountResource
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetResourceByAccountAddressAndResourceType", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/resource/%s", address, resourceType),
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
@@ -149,32 +168,48 @@ type Field struct {
 	Type string `json:"type"`
 }
 
-func (impl AccountsImpl) GetAccountModules(ctx context.Context, address string, opts ...interface{}) ([]AccountModule, error) {
+func (impl AccountsImpl) GetAccountModules(ctx context.Context, address string, opts ...RequestOption) ([]AccountModule, error) {
 	var rspJSON []AccountModule
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetAccountModules", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/modules", address),
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
-		return nil, fmt.Errorf("client.GetAccountModules error: %w", err)
+		return nil, err
 	}
 
 	return rspJSON, nil
 }
 
-func (impl AccountsImpl) GetModuleByModuleID(ctx context.Context, address, moduleID string, opts ...interface{}) (*AccountModule, error) {
+// GetAccountModulesPage returns a page of at most limit modules starting at the cursor start, along with the
+// cursor of the next page, which is empty on the last page.
+func (impl AccountsImpl) GetAccountModulesPage(ctx context.Context, address, start string, limit uint64, opts ...RequestOption) ([]AccountModule, string, error) {
+	var rspJSON []AccountModule
+	var header ResponseHeader
+	err := impl.Base.request(ctx, "GetAccountModulesPage", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/modules", address),
+		nil, &rspJSON, pageQuery(start, limit), requestOptions(append(opts[:len(opts):len(opts)], &header)...))
+	copyResponseHeader(opts, header)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rspJSON, header.AptosCursor, nil
+}
+
+func (impl AccountsImpl) GetModuleByModuleID(ctx context.Context, address, moduleID string, opts ...RequestOption) (*AccountModule, error) {
 	var rspJSON AccountModule
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetModuleByModuleID", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/module/%s", address, moduleID),
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
-		return nil, fmt.Errorf("client.GetModuleByModuleID error: %w", err)
+		return nil, err
 	}
 
 	return &rspJSON, nil
 }
 
-func (impl AccountsImpl) GetResourceWithCustomType(ctx context.Context, address, resourceType string, resp interface{}, opts ...interface{}) error {
-	err := request(ctx, http.MethodGet,
+func (impl AccountsImpl) GetResourceWithCustomType(ctx context.Context, address, resourceType string, resp interface{}, opts ...RequestOption) error {
+	err := impl.Base.request(ctx, "GetResourceWithCustomType", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/resource/%s", address, resourceType),
 		nil, resp, nil, requestOptions(opts...))
 	if err != nil {
@@ -82,3 +82,131 @@ func TestGetModules(t *testing.T) {
 		assert.Equal(t, true, e.IsErrorCode(ErrAccountNotFound))
 	})
 }
+
+func TestVersionPruned(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "5", req.URL.Query().Get("ledger_version"))
+		w.Header().Set("X-Aptos-Ledger-Oldest-Version", "1000")
+		w.WriteHeader(http.StatusGone)
+		_, err := w.Write([]byte(`{"message":"Ledger version(5) has been pruned","error_code":"version_pruned","vm_error_code":null}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	var header ResponseHeader
+	c := NewAptosClient(srv.URL)
+	_, err := c.GetAccountResources(ctx, mockAddr, WithLedgerVersion(5), &header)
+
+	var pruned *VersionPrunedError
+	if assert.True(t, errors.As(err, &pruned)) {
+		assert.Equal(t, uint64(5), pruned.Version)
+		assert.Equal(t, uint64(1000), pruned.OldestVersion)
+	}
+	var e *Error
+	if assert.True(t, errors.As(err, &e)) {
+		assert.True(t, e.HasErrorCode(ErrVersionPruned))
+	}
+	assert.Equal(t, uint64(1000), header.AptosLedgerOldestVersion)
+}
+
+func TestWalkAccountResources(t *testing.T) {
+	pages := map[string]struct {
+		body, next string
+	}{
+		"":   {`[{"type":"0x1::account::Account","data":{}}]`, "c1"},
+		"c1": {`[{"type":"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>","data":{}}]`, "c2"},
+		"c2": {`[{"type":"0x3::token::TokenStore","data":{}}]`, ""},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := req.URL.Query().Get("start")
+		assert.Equal(t, "1", req.URL.Query().Get("limit"))
+		if start == "" {
+			assert.Empty(t, req.URL.Query().Get("ledger_version"))
+		} else {
+			assert.Equal(t, "100", req.URL.Query().Get("ledger_version"))
+		}
+
+		page := pages[start]
+		w.Header().Set("X-Aptos-Ledger-Version", "100")
+		if page.next != "" {
+			w.Header().Set("X-Aptos-Cursor", page.next)
+		}
+		_, err := w.Write([]byte(page.body))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+
+	resources, next, err := c.GetAccountResourcesPage(ctx, mockAddr, "", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "c1", next)
+	assert.Equal(t, 1, len(resources))
+
+	var types []string
+	err = WalkAccountResources(ctx, c, mockAddr, 1, func(resources []AccountResource) error {
+		for _, r := range resources {
+			types = append(types, r.Type)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"0x1::account::Account",
+		"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>",
+		"0x3::token::TokenStore",
+	}, types)
+}
+
+func TestErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1/accounts/" + mockAddr:
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(errResp))
+			assert.NoError(t, err)
+		case "/v1/accounts/" + mockAddr + "/resources":
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"message":"Invalid input","vm_error_code":7}`))
+			assert.NoError(t, err)
+		case "/v1/transactions":
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"message":"Invalid transaction: Type: Validation Code: INVALID_SIGNATURE","error_code":"vm_error","vm_error_code":1}`))
+			assert.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, err := w.Write([]byte("bad gateway"))
+			assert.NoError(t, err)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+
+	_, err := c.GetAccount(ctx, mockAddr)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	assert.NotErrorIs(t, err, ErrModuleNotFound)
+	assert.False(t, IsRetryable(err))
+
+	_, err = c.GetAccountModules(ctx, mockAddr)
+	var e *Error
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, http.StatusBadGateway, e.StatusCode)
+		assert.EqualError(t, e, "response(502): bad gateway")
+	}
+	assert.True(t, IsRetryable(err))
+
+	// an error body without error_code keeps its fields
+	_, err = c.GetAccountResources(ctx, mockAddr)
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, &Error{StatusCode: http.StatusBadRequest, Message: "Invalid input", VMErrorCode: 7}, e)
+	}
+
+	_, err = c.GetTransactions(ctx, 0, 1)
+	assert.ErrorIs(t, err, ErrVMError)
+	assert.ErrorIs(t, err, ErrVMInvalidSignature)
+	assert.NotErrorIs(t, err, ErrVMSequenceNumberTooOld)
+
+	assert.True(t, (&Error{StatusCode: http.StatusServiceUnavailable, ErrorCode: ErrMempoolIsFull}).Retryable())
+	assert.False(t, IsRetryable(context.Canceled))
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+const (
+	defaultBackfillChunkSize   = 100
+	defaultBackfillConcurrency = 4
+)
+
+// BackfillConfig configures Backfill. Zero values use the defaults.
+type BackfillConfig struct {
+	// ChunkSize is the number of versions fetched by a worker at once, 100 by default.
+	ChunkSize int
+	// Concurrency is the maximum number of chunks fetched or buffered at the same time, 4 by default.
+	Concurrency int
+	// RetryPolicy controls the retries of a failed chunk, DefaultRetryPolicy by default.
+	RetryPolicy *RetryPolicy
+	// Progress, if set, is called after the transactions of each chunk were delivered.
+	Progress func(progress BackfillProgress)
+}
+
+// BackfillProgress reports the progress of Backfill.
+type BackfillProgress struct {
+	Start, End uint64
+	// Version is the version of the next transaction to deliver, the checkpoint to resume from.
+	Version uint64
+	// Delivered is the number of transactions delivered so far.
+	Delivered uint64
+}
+
+type backfillChunk struct {
+	txs []TransactionResp
+	err error
+}
+
+// Backfill fetches the transactions of versions [start, end) in chunks with bounded concurrency and calls fn
+// with each of them in strict version order. It stops at the first error, including one returned by fn or a
+// chunk failing with a non retryable error or after its retries, or when ctx is done.
+func Backfill(ctx context.Context, client Transactions, start, end uint64, cfg BackfillConfig,
+	fn func(tx TransactionResp) error, opts ...RequestOption) error {
+
+	if cfg.ChunkSize <= 0 {
+		cfg.ChunkSize = defaultBackfillChunkSize
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = defaultBackfillConcurrency
+	}
+	policy := DefaultRetryPolicy
+	if cfg.RetryPolicy != nil {
+		policy = *cfg.RetryPolicy
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// a slot of sem is held by a chunk from the start of its fetch until its delivery, bounding both the
+	// concurrent calls and the buffered transactions
+	sem := make(chan struct{}, cfg.Concurrency)
+	chunks := make(chan chan backfillChunk, cfg.Concurrency)
+	go func() {
+		defer close(chunks)
+		for from := start; from < end; from += uint64(cfg.ChunkSize) {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			to := from + uint64(cfg.ChunkSize)
+			if to > end {
+				to = end
+			}
+			ch := make(chan backfillChunk, 1)
+			go func(from, to uint64) {
+				txs, err := fetchChunk(ctx, client, from, to, policy, opts)
+				ch <- backfillChunk{txs: txs, err: err}
+			}(from, to)
+
+			select {
+			case chunks <- ch:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	progress := BackfillProgress{Start: start, End: end, Version: start}
+	for ch := range chunks {
+		var chunk backfillChunk
+		select {
+		case chunk = <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		<-sem
+
+		if chunk.err != nil {
+			return chunk.err
+		}
+		for _, tx := range chunk.txs {
+			if err := fn(tx); err != nil {
+				return err
+			}
+			progress.Version++
+			progress.Delivered++
+		}
+		if cfg.Progress != nil {
+			cfg.Progress(progress)
+		}
+	}
+
+	return ctx.Err()
+}
+
+// fetchChunk returns the transactions of versions [from, to), retrying the calls failing with a retryable error
+// according to policy. The attempts are counted from the last call which made progress.
+func fetchChunk(ctx context.Context, client Transactions, from, to uint64, policy RetryPolicy,
+	opts []RequestOption) ([]TransactionResp, error) {
+
+	txs := make([]TransactionResp, 0, to-from)
+	for attempt := 1; uint64(len(txs)) < to-from; {
+		next := from + uint64(len(txs))
+		page, err := client.GetTransactions(ctx, int(next), int(to-next), opts...)
+		retryable := IsRetryable(err)
+		if err == nil && len(page) == 0 {
+			// the node may lag behind the others
+			err = fmt.Errorf("no transactions from version %d", next)
+			retryable = true
+		}
+		if err != nil {
+			if attempt >= policy.MaxAttempts || !retryable || ctx.Err() != nil {
+				return nil, fmt.Errorf("fetch versions [%d, %d) error: %w", from, to, err)
+			}
+			if err := sleepContext(ctx, policy.backoff(attempt)); err != nil {
+				return nil, err
+			}
+			attempt++
+			continue
+		}
+
+		attempt = 1
+		for _, tx := range page {
+			v, err := strconv.ParseUint(tx.Version, 10, 64)
+			if err != nil || v != from+uint64(len(txs)) {
+				return nil, fmt.Errorf("unexpected transaction version %q, expected %d", tx.Version, from+uint64(len(txs)))
+			}
+			txs = append(txs, tx)
+			if uint64(len(txs)) == to-from {
+				break
+			}
+		}
+	}
+
+	return txs, nil
+}
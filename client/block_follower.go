@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// BlockFollowerConfig configures a BlockFollower.
+type BlockFollowerConfig struct {
+	// FromLatest starts the follower at the latest block height of LedgerInformation instead of the given one.
+	FromLatest bool
+	// SkipPruned moves the follower to the oldest available block when its height was pruned by the node,
+	// instead of failing with a *BlockPrunedError. The follower still fails if the node does not report an
+	// oldest block height above the pruned one.
+	SkipPruned bool
+	// PollInterval is the delay between polls once the follower reached the head of the chain, 1s by default.
+	PollInterval time.Duration
+}
+
+// BlockFollower yields the blocks of the chain in height order with all their transactions, waiting for new
+// blocks once it reaches the head of the chain.
+//
+// A BlockFollower is not safe for concurrent use.
+type BlockFollower struct {
+	client       AptosClient
+	opts         []RequestOption
+	fromLatest   bool
+	skipPruned   bool
+	pollInterval time.Duration
+
+	height uint64
+}
+
+// NewBlockFollower creates a BlockFollower starting at block height start. To resume after a restart, pass the
+// Height of the follower saved at the last checkpoint.
+func NewBlockFollower(client AptosClient, start uint64, cfg BlockFollowerConfig, opts ...RequestOption) *BlockFollower {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultIteratorPollInterval
+	}
+
+	return &BlockFollower{
+		client:       client,
+		opts:         opts,
+		fromLatest:   cfg.FromLatest,
+		skipPruned:   cfg.SkipPruned,
+		pollInterval: cfg.PollInterval,
+		height:       start,
+	}
+}
+
+// Height returns the height of the next block returned by Next. It is the checkpoint to resume from.
+func (f *BlockFollower) Height() uint64 {
+	return f.height
+}
+
+// Next returns the next block with its complete list of transactions, waiting for it to be committed if needed.
+// It returns an error if a call fails or ctx is done, in which case Next can be called again to retry.
+func (f *BlockFollower) Next(ctx context.Context) (*Block, error) {
+	if f.fromLatest {
+		info, err := f.client.LedgerInformation(ctx, f.opts...)
+		if err != nil {
+			return nil, err
+		}
+		height, err := strconv.ParseUint(info.BlockHeight, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse block height error: %w", err)
+		}
+		f.height = height
+		f.fromLatest = false
+	}
+
+	for {
+		var header ResponseHeader
+		block, err := f.client.GetBlocksByHeight(ctx, f.height, true, append(f.opts[:len(f.opts):len(f.opts)], &header)...)
+		if err == nil {
+			if err := f.fillTransactions(ctx, block); err != nil {
+				return nil, err
+			}
+			f.height++
+			return block, nil
+		}
+
+		var e *Error
+		if !errors.As(err, &e) {
+			return nil, err
+		}
+
+		switch {
+		case e.HasErrorCode(ErrBlockPruned) || header.AptosOldestBlockHeight > f.height:
+			// without a usable oldest height, skipping would read pruned blocks again
+			if !f.skipPruned || header.AptosOldestBlockHeight <= f.height {
+				return nil, &BlockPrunedError{Height: f.height, OldestHeight: header.AptosOldestBlockHeight, Err: err}
+			}
+			f.height = header.AptosOldestBlockHeight
+		case e.HasErrorCode(ErrBlockNotFound):
+			if err := sleepContext(ctx, f.pollInterval); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, err
+		}
+	}
+}
+
+// fillTransactions fetches the transactions of block left out of the response by the page size of the node.
+func (f *BlockFollower) fillTransactions(ctx context.Context, block *Block) error {
+	first, err := strconv.ParseUint(block.FirstVersion, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse first version error: %w", err)
+	}
+	last, err := strconv.ParseUint(block.LastVersion, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse last version error: %w", err)
+	}
+
+	count := last - first + 1
+	for uint64(len(block.Transactions)) < count {
+		start := first + uint64(len(block.Transactions))
+		txs, err := f.client.GetTransactions(ctx, int(start), int(count)-len(block.Transactions), f.opts...)
+		if err != nil {
+			return err
+		}
+		if len(txs) == 0 {
+			return fmt.Errorf("missing transactions of block %s from version %d", block.BlockHeight, start)
+		}
+		if uint64(len(block.Transactions)+len(txs)) > count {
+			txs = txs[:count-uint64(len(block.Transactions))]
+		}
+		block.Transactions = append(block.Transactions, txs...)
+	}
+
+	return nil
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/portto/aptos-go-sdk/models"
 )
 
 type Blocks interface {
-	GetBlocksByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...interface{}) (*Block, error)
-	GetBlocksByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...interface{}) (*Block, error)
+	GetBlocksByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...RequestOption) (*Block, error)
+	GetBlocksByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...RequestOption) (*Block, error)
+	GetTypedBlockByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...RequestOption) (*TypedBlock, error)
+	GetTypedBlockByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...RequestOption) (*TypedBlock, error)
 }
 
 type BlocksImpl struct {
@@ -24,9 +28,19 @@ type Block struct {
 	Transactions   []TransactionResp `json:"transactions"`
 }
 
-func (impl BlocksImpl) GetBlocksByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...interface{}) (*Block, error) {
+// TypedBlock is a block whose transactions are decoded into their typed variants.
+type TypedBlock struct {
+	BlockHeight    models.Uint64       `json:"block_height"`
+	BlockHash      string              `json:"block_hash"`
+	BlockTimestamp models.Uint64       `json:"block_timestamp"`
+	FirstVersion   models.Uint64       `json:"first_version"`
+	LastVersion    models.Uint64       `json:"last_version"`
+	Transactions   TransactionVariants `json:"transactions"`
+}
+
+func (impl BlocksImpl) GetBlocksByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...RequestOption) (*Block, error) {
 	var rspJSON Block
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetBlocksByHeight", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/blocks/by_height/%d", height),
 		nil, &rspJSON, map[string]interface{}{
 			"with_transactions": withTransactions,
@@ -38,9 +52,37 @@ func (impl BlocksImpl) GetBlocksByHeight(ctx context.Context, height uint64, wit
 	return &rspJSON, nil
 }
 
-func (impl BlocksImpl) GetBlocksByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...interface{}) (*Block, error) {
+func (impl BlocksImpl) GetBlocksByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...RequestOption) (*Block, error) {
 	var rspJSON Block
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetBlocksByVersion", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/blocks/by_version/%d", version),
+		nil, &rspJSON, map[string]interface{}{
+			"with_transactions": withTransactions,
+		}, requestOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &rspJSON, nil
+}
+
+func (impl BlocksImpl) GetTypedBlockByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...RequestOption) (*TypedBlock, error) {
+	var rspJSON TypedBlock
+	err := impl.Base.request(ctx, "GetTypedBlockByHeight", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/blocks/by_height/%d", height),
+		nil, &rspJSON, map[string]interface{}{
+			"with_transactions": withTransactions,
+		}, requestOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &rspJSON, nil
+}
+
+func (impl BlocksImpl) GetTypedBlockByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...RequestOption) (*TypedBlock, error) {
+	var rspJSON TypedBlock
+	err := impl.Base.request(ctx, "GetTypedBlockByVersion", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/blocks/by_version/%d", version),
 		nil, &rspJSON, map[string]interface{}{
 			"with_transactions": withTransactions,
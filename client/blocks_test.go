@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockFollower(t *testing.T) {
+	// block 3 holds versions 10-14 of which only 2 fit in the node page, block 4 holds version 15
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Aptos-Oldest-Block-Height", "3")
+		switch {
+		case req.URL.Path == "/v1":
+			_, err := w.Write([]byte(`{"block_height":"4"}`))
+			assert.NoError(t, err)
+		case req.URL.Path == "/v1/transactions":
+			start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+			limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+			var txs []TransactionResp
+			for v := start; v < start+limit && v <= 14 && len(txs) < 2; v++ {
+				txs = append(txs, TransactionResp{Version: strconv.Itoa(v)})
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(txs))
+		case strings.HasPrefix(req.URL.Path, "/v1/blocks/by_height/"):
+			height, _ := strconv.Atoi(strings.TrimPrefix(req.URL.Path, "/v1/blocks/by_height/"))
+			var body string
+			switch {
+			case height < 3:
+				w.WriteHeader(http.StatusGone)
+				body = `{"message":"pruned","error_code":"block_pruned"}`
+			case height == 3:
+				body = `{"block_height":"3","first_version":"10","last_version":"14","transactions":[{"version":"10"},{"version":"11"}]}`
+			case height == 4:
+				body = `{"block_height":"4","first_version":"15","last_version":"15","transactions":[{"version":"15"}]}`
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				body = `{"message":"not found","error_code":"block_not_found"}`
+			}
+			_, err := w.Write([]byte(body))
+			assert.NoError(t, err)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+
+	t.Run("Pruned", func(t *testing.T) {
+		_, err := NewBlockFollower(c, 1, BlockFollowerConfig{}).Next(mockCTX)
+		var pruned *BlockPrunedError
+		if assert.ErrorAs(t, err, &pruned) {
+			assert.Equal(t, uint64(3), pruned.OldestHeight)
+		}
+	})
+
+	t.Run("SkipPruned", func(t *testing.T) {
+		f := NewBlockFollower(c, 1, BlockFollowerConfig{SkipPruned: true})
+		block, err := f.Next(mockCTX)
+		assert.NoError(t, err)
+		assert.Equal(t, "3", block.BlockHeight)
+		var versions []string
+		for _, tx := range block.Transactions {
+			versions = append(versions, tx.Version)
+		}
+		assert.Equal(t, []string{"10", "11", "12", "13", "14"}, versions)
+
+		block, err = f.Next(mockCTX)
+		assert.NoError(t, err)
+		assert.Equal(t, "4", block.BlockHeight)
+		assert.Equal(t, uint64(5), f.Height())
+	})
+
+	t.Run("SkipPrunedWithoutOldestHeight", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusGone)
+			_, err := w.Write([]byte(`{"message":"pruned","error_code":"block_pruned"}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		f := NewBlockFollower(NewAptosClient(srv.URL), 1, BlockFollowerConfig{SkipPruned: true})
+		_, err := f.Next(mockCTX)
+		var pruned *BlockPrunedError
+		assert.ErrorAs(t, err, &pruned)
+		assert.Equal(t, uint64(1), f.Height())
+	})
+
+	t.Run("FromLatest", func(t *testing.T) {
+		f := NewBlockFollower(c, 0, BlockFollowerConfig{FromLatest: true, PollInterval: time.Millisecond})
+		block, err := f.Next(mockCTX)
+		assert.NoError(t, err)
+		assert.Equal(t, "4", block.BlockHeight)
+
+		ctx, cancel := context.WithTimeout(mockCTX, 20*time.Millisecond)
+		defer cancel()
+		_, err = f.Next(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, uint64(5), f.Height())
+	})
+}
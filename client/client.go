@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/the729/lcs"
@@ -17,22 +19,10 @@ import (
 
 //go:generate mockery --name AptosClient --filename mock_client.go --inpackage
 
-var client *http.Client
-
-func init() {
-	client = &http.Client{
-		Timeout: 30 * time.Second,
-	}
-}
-
-func WithTimeout(timeout time.Duration) {
-	client.Timeout = timeout
-}
-
 // NewAptosClient creates AptosClient for Aptos access APIs
-func NewAptosClient(endpoint string) AptosClient {
+func NewAptosClient(endpoint string, opts ...Option) AptosClient {
 	impl := &AptosClientImpl{
-		APIBase: APIBase{endpoint: endpoint},
+		APIBase: newAPIBase(endpoint, opts...),
 	}
 
 	impl.GeneralImp.Base = impl.APIBase
@@ -41,6 +31,7 @@ func NewAptosClient(endpoint string) AptosClient {
 	impl.StateImpl.Base = impl.APIBase
 	impl.EventsImpl.Base = impl.APIBase
 	impl.TransactionsImpl.Base = impl.APIBase
+	impl.ViewsImpl.Base = impl.APIBase
 	return impl
 }
 
@@ -53,18 +44,54 @@ type AptosClientImpl struct {
 	AccountsImpl
 	EventsImpl
 	StateImpl
+	ViewsImpl
 }
 
 type APIBase struct {
-	endpoint string
+	endpoint     string
+	httpClient   *http.Client
+	headers      http.Header
+	retryPolicy  RetryPolicy
+	rateLimiter  *RateLimiter
+	interceptors []Interceptor
+	tracing      Tracer
+}
+
+func newAPIBase(endpoint string, opts ...Option) APIBase {
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return APIBase{
+		endpoint:     endpoint,
+		httpClient:   o.buildHTTPClient(),
+		headers:      o.buildHeaders(),
+		retryPolicy:  o.retryPolicy,
+		rateLimiter:  o.rateLimiter,
+		interceptors: o.interceptors,
+		tracing:      o.tracer,
+	}
 }
 
 func (impl APIBase) Endpoint() string {
 	return impl.endpoint
 }
 
+func (impl APIBase) tracer() Tracer {
+	if impl.tracing == nil {
+		return noopTracer{}
+	}
+	return impl.tracing
+}
+
 type Base interface {
 	Endpoint() string
+
+	tracer() Tracer
+
+	request(ctx context.Context, operation, method, endpoint string, reqBody, resp interface{},
+		query map[string]interface{}, cfg *requestConfig) error
 }
 
 type AptosClient interface {
@@ -74,6 +101,7 @@ type AptosClient interface {
 	Accounts
 	Events
 	State
+	Views
 }
 
 type ResponseHeader struct {
@@ -84,40 +112,167 @@ type ResponseHeader struct {
 	AptosLedgerTimestampusec uint64
 	AptosLedgerVersion       uint64
 	AptosOldestBlockHeight   uint64
+	AptosCursor              string
+}
+
+// request sends a REST call on behalf of the client method named operation.
+func (impl APIBase) request(ctx context.Context, operation, method, endpoint string, reqBody, resp interface{},
+	query map[string]interface{}, cfg *requestConfig) error {
+
+	if cfg == nil {
+		cfg = &requestConfig{}
+	}
+
+	if cfg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.timeout)
+		defer cancel()
+	}
+
+	if cfg.ledgerVersion != nil {
+		q := make(map[string]interface{}, len(query)+1)
+		for k, v := range query {
+			q[k] = v
+		}
+		q["ledger_version"] = *cfg.ledgerVersion
+		query = q
+	}
+
+	info := &CallInfo{
+		Operation: operation,
+		Method:    method,
+		Endpoint:  endpoint,
+		Start:     time.Now(),
+	}
+
+	ctx, span := impl.tracer().Start(ctx, operation)
+	if u, err := url.Parse(endpoint); err == nil {
+		span.SetAttributes(Attribute{Key: AttributeEndpointPath, Value: u.Path})
+	}
+	if tx, ok := reqBody.(models.UserTransaction); ok {
+		if hash, err := (&models.Transaction{UserTransaction: tx}).GetHash(); err == nil {
+			span.SetAttributes(Attribute{Key: AttributeTransactionHash, Value: hash})
+		}
+	}
+
+	var header ResponseHeader
+	err := impl.call(ctx, info, reqBody, resp, query, cfg, &header)
+	if err != nil && cfg.ledgerVersion != nil {
+		var e *Error
+		if errors.As(err, &e) && e.HasErrorCode(ErrVersionPruned) {
+			err = &VersionPrunedError{
+				Version:       *cfg.ledgerVersion,
+				OldestVersion: header.AptosLedgerOldestVersion,
+				Err:           err,
+			}
+		}
+	}
+	if err != nil {
+		for i := len(impl.interceptors) - 1; i >= 0; i-- {
+			err = impl.interceptors[i].InterceptError(info, err)
+		}
+	}
+	if cfg.respHeader != nil {
+		*cfg.respHeader = header
+	}
+
+	span.SetAttributes(
+		Attribute{Key: AttributeHTTPStatusCode, Value: info.StatusCode},
+		Attribute{Key: AttributeAttempts, Value: info.Attempt},
+	)
+	if header.AptosLedgerVersion > 0 {
+		span.SetAttributes(Attribute{Key: AttributeLedgerVersion, Value: header.AptosLedgerVersion})
+	}
+	if err != nil {
+		span.SetAttributes(Attribute{Key: AttributeErrorClass, Value: errorClass(info.StatusCode, err)})
+		var e *Error
+		if errors.As(err, &e) && e.ErrorCode != "" {
+			span.SetAttributes(Attribute{Key: AttributeErrorCode, Value: string(e.ErrorCode)})
+		}
+	}
+	span.End(err)
+	return err
 }
 
-func request(ctx context.Context, method, endpoint string, reqBody, resp interface{},
-	query map[string]interface{}, respHeader *ResponseHeader) error {
+func (impl APIBase) call(ctx context.Context, info *CallInfo, reqBody, resp interface{},
+	query map[string]interface{}, cfg *requestConfig, respHeader *ResponseHeader) error {
 
 	var reqBytes []byte
 	var err error
-	var body io.Reader = http.NoBody
 
-	_, isReqBodyTxn := reqBody.(models.UserTransaction)
-	if reqBody != nil {
-		if isReqBodyTxn {
-			reqBytes, err = lcs.Marshal(reqBody)
-			if err != nil {
-				return err
-			}
-		} else {
-			reqBytes, err = json.Marshal(reqBody)
-			if err != nil {
-				return err
+	contentType := "application/json"
+	switch body := reqBody.(type) {
+	case nil:
+	case models.UserTransaction:
+		contentType = "application/x.aptos.signed_transaction+bcs"
+		reqBytes, err = lcs.Marshal(body)
+	case models.ViewFunction:
+		contentType = "application/x.aptos.view_function+bcs"
+		reqBytes, err = lcs.Marshal(body)
+	default:
+		reqBytes, err = json.Marshal(body)
+	}
+	if err != nil {
+		return err
+	}
+
+	policy := impl.retryPolicy
+	retryable := isIdempotent(info.Method, info.Endpoint)
+	for attempt := 1; ; attempt++ {
+		if err := impl.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		info.Attempt = attempt
+		statusCode, retryAfter, err := impl.send(ctx, info, contentType, reqBytes, resp, query, cfg, respHeader)
+		if err == nil {
+			return nil
+		}
+
+		if !retryable || attempt >= policy.MaxAttempts || !shouldRetry(ctx, statusCode, err) {
+			if attempt > 1 {
+				return &RetryError{Attempts: attempt, Err: err}
 			}
+			return err
 		}
+
+		wait := policy.backoff(attempt)
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return &RetryError{Attempts: attempt, Err: err}
+		}
+	}
+}
+
+// send performs a single attempt of request. It returns the HTTP status code, which is 0 if no response was
+// received or an interceptor rejected it, and the delay requested by a Retry-After header along with the error.
+func (impl APIBase) send(ctx context.Context, info *CallInfo, contentType string, reqBytes []byte,
+	resp interface{}, query map[string]interface{}, cfg *requestConfig, respHeader *ResponseHeader) (int, time.Duration, error) {
+
+	var body io.Reader = http.NoBody
+	if reqBytes != nil {
 		body = bytes.NewReader(reqBytes)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, info.Method, info.Endpoint, body)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
-	if isReqBodyTxn {
-		req.Header.Add("Content-Type", "application/x.aptos.signed_transaction+bcs")
-	} else {
-		req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Content-Type", contentType)
+
+	if cfg.bcs != nil {
+		req.Header.Set("Accept", "application/x-bcs")
+	}
+
+	for _, headers := range []http.Header{impl.headers, cfg.headers} {
+		for k, vs := range headers {
+			for _, v := range vs {
+				req.Header.Add(k, v)
+			}
+		}
 	}
 
 	if req.URL != nil && query != nil {
@@ -128,76 +283,100 @@ func request(ctx context.Context, method, endpoint string, reqBody, resp interfa
 		req.URL.RawQuery = q.Encode()
 	}
 
-	rsp, err := client.Do(req)
+	for _, interceptor := range impl.interceptors {
+		if err := interceptor.InterceptRequest(info, req); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	httpClient := impl.httpClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+
+	info.StatusCode = 0
+	rsp, err := httpClient.Do(req)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 	defer rsp.Body.Close()
+	info.StatusCode = rsp.StatusCode
 
 	rspBody, err := io.ReadAll(rsp.Body)
 	if err != nil {
-		return err
+		return rsp.StatusCode, 0, err
+	}
+
+	for i := len(impl.interceptors) - 1; i >= 0; i-- {
+		if err := impl.interceptors[i].InterceptResponse(info, rsp, rspBody); err != nil {
+			return 0, 0, err
+		}
 	}
 
+	parseResponseHeader(rsp.Header, respHeader)
+
 	if rsp.StatusCode != http.StatusOK && rsp.StatusCode != http.StatusAccepted {
+		retryAfter := parseRetryAfter(rsp.Header.Get("Retry-After"))
+
 		var err Error
-		if json.Unmarshal(rspBody, &err) == nil {
-			err.StatusCode = rsp.StatusCode
-			return &err
+		if json.Unmarshal(rspBody, &err) != nil {
+			err = Error{}
 		}
-		return fmt.Errorf("response(%d): %s", rsp.StatusCode, string(rspBody))
+		if err.Message == "" {
+			err.Message = string(rspBody)
+		}
+		err.StatusCode = rsp.StatusCode
+		return rsp.StatusCode, retryAfter, &err
 	}
 
-	err = json.Unmarshal(rspBody, resp)
-	if err != nil {
-		return err
+	if cfg.bcs != nil {
+		*cfg.bcs = rspBody
+	} else if err := json.Unmarshal(rspBody, resp); err != nil {
+		return rsp.StatusCode, 0, err
 	}
 
+	return rsp.StatusCode, 0, nil
+}
+
+func parseResponseHeader(header http.Header, respHeader *ResponseHeader) {
 	if respHeader != nil {
-		if len(rsp.Header["X-Aptos-Block-Height"]) > 0 {
-			v, _ := new(big.Int).SetString(rsp.Header["X-Aptos-Block-Height"][0], 10)
+		if len(header["X-Aptos-Block-Height"]) > 0 {
+			v, _ := new(big.Int).SetString(header["X-Aptos-Block-Height"][0], 10)
 			respHeader.AptosBlockHeight = v.Uint64()
 		}
 
-		if len(rsp.Header["X-Aptos-Chain-Id"]) > 0 {
-			v, _ := new(big.Int).SetString(rsp.Header["X-Aptos-Chain-Id"][0], 10)
+		if len(header["X-Aptos-Chain-Id"]) > 0 {
+			v, _ := new(big.Int).SetString(header["X-Aptos-Chain-Id"][0], 10)
 			respHeader.AptosChainID = uint16(v.Uint64())
 		}
 
-		if len(rsp.Header["X-Aptos-Epoch"]) > 0 {
-			v, _ := new(big.Int).SetString(rsp.Header["X-Aptos-Epoch"][0], 10)
+		if len(header["X-Aptos-Epoch"]) > 0 {
+			v, _ := new(big.Int).SetString(header["X-Aptos-Epoch"][0], 10)
 			respHeader.AptosEpoch = v.Uint64()
 		}
 
-		if len(rsp.Header["X-Aptos-Ledger-Oldest-Version"]) > 0 {
-			v, _ := new(big.Int).SetString(rsp.Header["X-Aptos-Ledger-Oldest-Version"][0], 10)
+		if len(header["X-Aptos-Ledger-Oldest-Version"]) > 0 {
+			v, _ := new(big.Int).SetString(header["X-Aptos-Ledger-Oldest-Version"][0], 10)
 			respHeader.AptosLedgerOldestVersion = v.Uint64()
 		}
 
-		if len(rsp.Header["X-Aptos-Ledger-Timestampusec"]) > 0 {
-			v, _ := new(big.Int).SetString(rsp.Header["X-Aptos-Ledger-Timestampusec"][0], 10)
+		if len(header["X-Aptos-Ledger-Timestampusec"]) > 0 {
+			v, _ := new(big.Int).SetString(header["X-Aptos-Ledger-Timestampusec"][0], 10)
 			respHeader.AptosLedgerTimestampusec = v.Uint64()
 		}
 
-		if len(rsp.Header["X-Aptos-Ledger-Version"]) > 0 {
-			v, _ := new(big.Int).SetString(rsp.Header["X-Aptos-Ledger-Version"][0], 10)
+		if len(header["X-Aptos-Ledger-Version"]) > 0 {
+			v, _ := new(big.Int).SetString(header["X-Aptos-Ledger-Version"][0], 10)
 			respHeader.AptosLedgerVersion = v.Uint64()
 		}
 
-		if len(rsp.Header["X-Aptos-Oldest-Block-Height"]) > 0 {
-			v, _ := new(big.Int).SetString(rsp.Header["X-Aptos-Oldest-Block-Height"][0], 10)
+		if len(header["X-Aptos-Oldest-Block-Height"]) > 0 {
+			v, _ := new(big.Int).SetString(header["X-Aptos-Oldest-Block-Height"][0], 10)
 			respHeader.AptosOldestBlockHeight = v.Uint64()
 		}
-	}
-	return nil
-}
 
-func requestOptions(opts ...interface{}) (rspHeader *ResponseHeader) {
-	for _, opt := range opts {
-		switch opt := opt.(type) {
-		case *ResponseHeader:
-			rspHeader = opt
+		if len(header["X-Aptos-Cursor"]) > 0 {
+			respHeader.AptosCursor = header["X-Aptos-Cursor"][0]
 		}
 	}
-	return
 }
@@ -2,14 +2,18 @@ package client
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/portto/aptos-go-sdk/models"
 )
 
 func TestRequest(t *testing.T) {
@@ -20,7 +24,7 @@ func TestRequest(t *testing.T) {
 			assert.NoError(t, err)
 		}))
 		var resp string
-		err := request(mockCTX, "GET", srv.URL, nil, &resp, nil, nil)
+		err := APIBase{}.request(mockCTX, "Request", "GET", srv.URL, nil, &resp, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, mockMsg, resp)
 	})
@@ -35,8 +39,393 @@ func TestRequest(t *testing.T) {
 		var resp string
 		ctx, cancel := context.WithTimeout(mockCTX, 10*time.Millisecond)
 		defer cancel()
-		err := request(ctx, "GET", srv.URL, nil, &resp, nil, nil)
+		err := APIBase{}.request(ctx, "Request", "GET", srv.URL, nil, &resp, nil, nil)
 		assert.Equal(t, true, errors.Is(err, context.DeadlineExceeded))
 		assert.Equal(t, "", resp)
 	})
 }
+
+func TestClientOptions(t *testing.T) {
+	t.Run("Headers", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "secret", req.Header.Get("X-Api-Key"))
+			assert.Equal(t, "indexer/1.0", req.Header.Get("User-Agent"))
+			_, err := w.Write([]byte(`{"chain_id":1}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+		c := NewAptosClient(srv.URL, WithHeader("X-Api-Key", "secret"), WithUserAgent("indexer/1.0"))
+		info, err := c.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(1), info.ChainID)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+			_, err := w.Write([]byte(`{"chain_id":1}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+		slow := NewAptosClient(srv.URL, WithClientTimeout(10*time.Millisecond))
+		_, err := slow.LedgerInformation(mockCTX)
+		assert.Error(t, err)
+
+		c := NewAptosClient(srv.URL)
+		_, err = c.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Transport", func(t *testing.T) {
+		var called bool
+		rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			called = true
+			return http.DefaultTransport.RoundTrip(req)
+		})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			_, err := w.Write([]byte(`{"chain_id":1}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+		c := NewAptosClient(srv.URL, WithHTTPClient(&http.Client{}), WithTransport(rt))
+		_, err := c.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	t.Run("Success", func(t *testing.T) {
+		var count int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			count++
+			if count < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, err := w.Write([]byte(`{"chain_id":1}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+		c := NewAptosClient(srv.URL, WithRetryPolicy(policy))
+		_, err := c.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		var count int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			count++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+		c := NewAptosClient(srv.URL, WithRetryPolicy(policy))
+		_, err := c.LedgerInformation(mockCTX)
+		var retryErr *RetryError
+		assert.True(t, errors.As(err, &retryErr))
+		assert.Equal(t, 3, retryErr.Attempts)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("NonIdempotent", func(t *testing.T) {
+		var count int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			count++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+		fc := NewFaucetClient(srv.URL, &MockAptosClient{}, WithRetryPolicy(policy))
+		err := fc.FundAccount(mockCTX, mockAddress, 1)
+		assert.Error(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("NotRetryable", func(t *testing.T) {
+		var count int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			count++
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(errResp))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+		c := NewAptosClient(srv.URL, WithRetryPolicy(policy))
+		_, err := c.GetAccount(mockCTX, mockAddr)
+		var e *Error
+		assert.True(t, errors.As(err, &e))
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestRateLimiter(t *testing.T) {
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&count, 1)
+		_, err := w.Write([]byte(`{"chain_id":1}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	t.Run("Shared", func(t *testing.T) {
+		atomic.StoreInt32(&count, 0)
+		limiter := NewRateLimiter(0.001, 2)
+		c1 := NewAptosClient(srv.URL, WithRateLimiter(limiter))
+		c2 := NewAptosClient(srv.URL, WithRateLimiter(limiter))
+
+		_, err := c1.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+		_, err = c2.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+
+		// the burst is used up by both clients, so the next request waits for a token
+		ctx, cancel := context.WithTimeout(mockCTX, 10*time.Millisecond)
+		defer cancel()
+		_, err = c1.LedgerInformation(ctx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		c := NewAptosClient(srv.URL, WithRateLimiter(NewRateLimiter(0.1, 1)))
+		_, err := c.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(mockCTX, 10*time.Millisecond)
+		defer cancel()
+		_, err = c.LedgerInformation(ctx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+}
+
+func TestInterceptors(t *testing.T) {
+	t.Run("Order", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "token", req.Header.Get("Authorization"))
+			_, err := w.Write([]byte(`{"chain_id":1}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		var calls []string
+		record := func(name string) Interceptor {
+			return InterceptorFuncs{
+				Request: func(info *CallInfo, req *http.Request) error {
+					calls = append(calls, name+".request."+info.Operation)
+					req.Header.Set("Authorization", "token")
+					return nil
+				},
+				Response: func(info *CallInfo, rsp *http.Response, body []byte) error {
+					calls = append(calls, name+".response."+string(body))
+					return nil
+				},
+			}
+		}
+
+		c := NewAptosClient(srv.URL, WithInterceptors(record("a"), record("b")))
+		_, err := c.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"a.request.LedgerInformation",
+			"b.request.LedgerInformation",
+			`b.response.{"chain_id":1}`,
+			`a.response.{"chain_id":1}`,
+		}, calls)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, err := w.Write([]byte("bad request"))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		var operation string
+		wrapped := errors.New("wrapped")
+		fc := NewFaucetClient(srv.URL, &MockAptosClient{}, WithInterceptors(InterceptorFuncs{
+			Error: func(info *CallInfo, err error) error {
+				operation = info.Operation
+				return wrapped
+			},
+		}))
+		err := fc.FundAccount(mockCTX, mockAddress, 1)
+		assert.Equal(t, wrapped, err)
+		assert.Equal(t, "FundAccount", operation)
+	})
+
+	t.Run("RewriteURL", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "/proxy/v1", req.URL.Path)
+			_, err := w.Write([]byte(`{"chain_id":1}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		c := NewAptosClient(srv.URL, WithInterceptors(InterceptorFuncs{
+			Request: func(info *CallInfo, req *http.Request) error {
+				req.URL.Path = "/proxy" + req.URL.Path
+				return nil
+			},
+		}))
+		_, err := c.LedgerInformation(mockCTX)
+		assert.NoError(t, err)
+	})
+}
+
+type recordedSpan struct {
+	name   string
+	parent string
+	attrs  map[string]interface{}
+	err    error
+	ended  bool
+}
+
+type spanKey struct{}
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (r *recordingTracer) Start(ctx context.Context, operation string) (context.Context, Span) {
+	s := &recordedSpan{name: operation, attrs: map[string]interface{}{}}
+	if parent, ok := ctx.Value(spanKey{}).(*recordedSpan); ok {
+		s.parent = parent.name
+	}
+	r.spans = append(r.spans, s)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) End(err error) {
+	s.err = err
+	s.ended = true
+}
+
+func TestTracer(t *testing.T) {
+	t.Run("Attributes", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("X-Aptos-Ledger-Version", "42")
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(errResp))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		tracer := &recordingTracer{}
+		c := NewAptosClient(srv.URL, WithTracer(tracer))
+		_, err := c.GetAccount(mockCTX, mockAddr)
+		assert.Error(t, err)
+
+		assert.Equal(t, 1, len(tracer.spans))
+		span := tracer.spans[0]
+		assert.Equal(t, "GetAccount", span.name)
+		assert.True(t, span.ended)
+		assert.Equal(t, err, span.err)
+		assert.Equal(t, "/v1/accounts/"+mockAddr, span.attrs[AttributeEndpointPath])
+		assert.Equal(t, http.StatusNotFound, span.attrs[AttributeHTTPStatusCode])
+		assert.Equal(t, "account_not_found", span.attrs[AttributeErrorCode])
+		assert.Equal(t, ErrorClassClient, span.attrs[AttributeErrorClass])
+	})
+
+	t.Run("TokenClient", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var err error
+			switch req.URL.Path {
+			case "/v1/estimate_gas_price":
+				_, err = w.Write([]byte(`{"gas_estimate":100}`))
+			case "/v1/transactions":
+				w.WriteHeader(http.StatusAccepted)
+				_, err = w.Write([]byte(`{"hash":"0x1"}`))
+			default:
+				_, err = w.Write([]byte(`{"sequence_number":"1","authentication_key":"0x1"}`))
+			}
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		tracer := &recordingTracer{}
+		tokenClient := &TokenClientImpl{
+			client:  NewAptosClient(srv.URL, WithTracer(tracer)),
+			chainID: 1,
+		}
+		creator := models.NewSingleSigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+		hash, err := tokenClient.CreateCollection(mockCTX, creator, CreateCollectionRequest{Name: "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1", hash)
+
+		var names []string
+		for _, span := range tracer.spans {
+			names = append(names, span.parent+">"+span.name)
+			assert.True(t, span.ended)
+		}
+		assert.Equal(t, []string{
+			">CreateCollection",
+			"CreateCollection>BuildTransaction",
+			"BuildTransaction>GetAccount",
+			"BuildTransaction>EstimateGasPrice",
+			"CreateCollection>SignTransaction",
+			"CreateCollection>SubmitTransaction",
+		}, names)
+		assert.NotEmpty(t, tracer.spans[5].attrs[AttributeTransactionHash])
+	})
+}
+
+func TestRequestOptions(t *testing.T) {
+	t.Run("LedgerVersionAndHeaders", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "100", req.URL.Query().Get("ledger_version"))
+			assert.Equal(t, "v", req.Header.Get("X-Extra"))
+			w.Header().Set("X-Aptos-Ledger-Version", "100")
+			_, err := w.Write([]byte(`{"sequence_number":"1","authentication_key":"0x1"}`))
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		var header ResponseHeader
+		c := NewAptosClient(srv.URL)
+		_, err := c.GetAccount(mockCTX, mockAddr, WithLedgerVersion(100), WithRequestHeader("X-Extra", "v"), &header)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), header.AptosLedgerVersion)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+		}))
+		defer srv.Close()
+
+		c := NewAptosClient(srv.URL)
+		_, err := c.LedgerInformation(mockCTX, WithRequestTimeout(10*time.Millisecond))
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("BCS", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "application/x-bcs", req.Header.Get("Accept"))
+			_, err := w.Write([]byte{0x01, 0x02})
+			assert.NoError(t, err)
+		}))
+		defer srv.Close()
+
+		var raw []byte
+		c := NewAptosClient(srv.URL)
+		_, err := c.GetAccount(mockCTX, mockAddr, WithBCS(&raw))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x01, 0x02}, raw)
+	})
+}
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+type CoinClient interface {
+	// Balance returns the amount of coinType owned by owner. It fails with ErrResourceNotFound if owner did
+	// not register coinType.
+	Balance(ctx context.Context, owner models.AccountAddress, coinType models.TypeTag, opts ...RequestOption) (uint64, error)
+	// TotalBalance returns the amount of coinType owned by owner, summing its CoinStore and the primary store of
+	// the fungible asset paired with coinType after its migration to the fungible asset standard.
+	TotalBalance(ctx context.Context, owner models.AccountAddress, coinType models.TypeTag, opts ...RequestOption) (uint64, error)
+	CoinInfo(ctx context.Context, coinType models.TypeTag, opts ...RequestOption) (*CoinInfo, error)
+
+	Register(ctx context.Context, signer models.AccountSigner, coinType models.TypeTag) (string, error)
+	Transfer(ctx context.Context, sender models.AccountSigner, req TransferCoinsRequest) (string, error)
+	BatchTransfer(ctx context.Context, sender models.AccountSigner, req BatchTransferCoinsRequest) (string, error)
+	// WaitForTransaction waits for a transaction submitted by the client and returns the committed transaction.
+	WaitForTransaction(ctx context.Context, txHash string, waitOpts WaitOptions) (*TransactionResp, error)
+}
+
+// NewCoinClient creates CoinClient to do things with aptos coins, reading the chain ID of client with ctx.
+func NewCoinClient(ctx context.Context, client AptosClient) (CoinClient, error) {
+	chainID, err := ledgerChainID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CoinClientImpl{
+		client:  client,
+		chainID: chainID,
+	}, nil
+}
+
+type CoinClientImpl struct {
+	client  AptosClient
+	chainID uint8
+}
+
+var CoinModule models.Module
+var ManagedCoinModule models.Module
+var AptosAccountModule models.Module
+
+// AptosCoinType is the type of the native coin, 0x1::aptos_coin::AptosCoin.
+var AptosCoinType models.TypeTagStruct
+
+func init() {
+	moduleAddr, _ := models.HexToAccountAddress("0x1")
+	CoinModule = models.Module{
+		Address: moduleAddr,
+		Name:    "coin",
+	}
+	ManagedCoinModule = models.Module{
+		Address: moduleAddr,
+		Name:    "managed_coin",
+	}
+	AptosAccountModule = models.Module{
+		Address: moduleAddr,
+		Name:    "aptos_account",
+	}
+	AptosCoinType = models.TypeTagStruct{
+		Address: moduleAddr,
+		Module:  "aptos_coin",
+		Name:    "AptosCoin",
+	}
+}
+
+// CoinInfo is the metadata of a coin type. Supply is nil if the coin does not track its supply.
+type CoinInfo struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+	Supply   *big.Int
+}
+
+func (impl *CoinClientImpl) Balance(ctx context.Context, owner models.AccountAddress, coinType models.TypeTag, opts ...RequestOption) (uint64, error) {
+	resourceType := models.MoveTypeString(models.TypeTagStruct{
+		Address:    CoinModule.Address,
+		Module:     CoinModule.Name,
+		Name:       "CoinStore",
+		TypeParams: []models.TypeTag{coinType},
+	})
+
+	resource, err := impl.client.GetResourceByAccountAddressAndResourceType(
+		ctx, owner.PrefixZeroTrimmedHex(), resourceType, opts...,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if resource.Data.CoinStoreResource == nil {
+		return 0, errors.New("nil CoinStoreResource")
+	}
+
+	balance, err := strconv.ParseUint(resource.Data.Coin.Value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse balance error: %w", err)
+	}
+
+	return balance, nil
+}
+
+func (impl *CoinClientImpl) TotalBalance(ctx context.Context, owner models.AccountAddress, coinType models.TypeTag, opts ...RequestOption) (uint64, error) {
+	coinBalance, err := impl.Balance(ctx, owner, coinType, opts...)
+	if err != nil && !errors.Is(err, ErrResourceNotFound) {
+		return 0, err
+	}
+
+	metadata, ok, err := impl.pairedMetadata(ctx, coinType, opts...)
+	if err != nil || !ok {
+		return coinBalance, err
+	}
+
+	faBalance, err := fungibleAssetBalance(ctx, impl.client, owner, metadata, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	return coinBalance + faBalance, nil
+}
+
+// pairedMetadata returns the metadata object of the fungible asset paired with coinType, if any.
+func (impl *CoinClientImpl) pairedMetadata(ctx context.Context, coinType models.TypeTag, opts ...RequestOption) (models.AccountAddress, bool, error) {
+	// the metadata is an Option<Object<Metadata>>
+	var metadata []struct {
+		Vec []struct {
+			Inner string `json:"inner"`
+		} `json:"vec"`
+	}
+	if err := impl.client.View(ctx, CoinModule, "paired_metadata", []models.TypeTag{coinType}, nil, &metadata, opts...); err != nil {
+		return models.AccountAddress{}, false, err
+	}
+	if len(metadata) != 1 || len(metadata[0].Vec) != 1 {
+		return models.AccountAddress{}, false, nil
+	}
+
+	addr, err := models.HexToAccountAddress(metadata[0].Vec[0].Inner)
+	if err != nil {
+		return models.AccountAddress{}, false, fmt.Errorf("invalid paired metadata %q: %w", metadata[0].Vec[0].Inner, err)
+	}
+
+	return addr, true, nil
+}
+
+// CoinInfo reads the metadata of coinType from the account which defined it, and its supply with the
+// 0x1::coin::supply view function.
+func (impl *CoinClientImpl) CoinInfo(ctx context.Context, coinType models.TypeTag, opts ...RequestOption) (*CoinInfo, error) {
+	structType, ok := coinType.(models.TypeTagStruct)
+	if !ok {
+		return nil, fmt.Errorf("unexpected coin type %s", models.MoveTypeString(coinType))
+	}
+
+	resourceType := models.MoveTypeString(models.TypeTagStruct{
+		Address:    CoinModule.Address,
+		Module:     CoinModule.Name,
+		Name:       "CoinInfo",
+		TypeParams: []models.TypeTag{coinType},
+	})
+
+	var resource struct {
+		Data struct {
+			Name     string `json:"name"`
+			Symbol   string `json:"symbol"`
+			Decimals uint8  `json:"decimals"`
+		} `json:"data"`
+	}
+	if err := impl.client.GetResourceWithCustomType(
+		ctx, structType.Address.PrefixZeroTrimmedHex(), resourceType, &resource, opts...,
+	); err != nil {
+		return nil, err
+	}
+
+	// the supply is an Option<u128>
+	var supply []struct {
+		Vec []string `json:"vec"`
+	}
+	if err := impl.client.View(ctx, CoinModule, "supply", []models.TypeTag{coinType}, nil, &supply, opts...); err != nil {
+		return nil, err
+	}
+
+	info := &CoinInfo{
+		Name:     resource.Data.Name,
+		Symbol:   resource.Data.Symbol,
+		Decimals: resource.Data.Decimals,
+	}
+	if len(supply) == 1 && len(supply[0].Vec) == 1 {
+		var ok bool
+		if info.Supply, ok = new(big.Int).SetString(supply[0].Vec[0], 10); !ok {
+			return nil, fmt.Errorf("invalid supply %q", supply[0].Vec[0])
+		}
+	}
+
+	return info, nil
+}
+
+// Register creates the CoinStore of coinType of signer, needed to receive coins of types which are not
+// registered automatically.
+func (impl *CoinClientImpl) Register(ctx context.Context, signer models.AccountSigner, coinType models.TypeTag) (string, error) {
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "RegisterCoin", signer, models.EntryFunctionPayload{
+		Module:        ManagedCoinModule,
+		Function:      "register",
+		TypeArguments: []models.TypeTag{coinType},
+	})
+}
+
+// TransferCoinsRequest transfers Amount of CoinType to Receiver. A zero CoinType transfers AptosCoinType.
+type TransferCoinsRequest struct {
+	CoinType models.TypeTag
+	Receiver models.AccountAddress
+	Amount   uint64
+}
+
+// Transfer calls 0x1::aptos_account::transfer_coins, which creates the receiver account if needed.
+func (impl *CoinClientImpl) Transfer(ctx context.Context, sender models.AccountSigner, req TransferCoinsRequest) (string, error) {
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "TransferCoins", sender, models.EntryFunctionPayload{
+		Module:        AptosAccountModule,
+		Function:      "transfer_coins",
+		TypeArguments: []models.TypeTag{coinTypeOrDefault(req.CoinType)},
+		Arguments:     []interface{}{req.Receiver, req.Amount},
+	})
+}
+
+// BatchTransferCoinsRequest transfers Amounts[i] of CoinType to Receivers[i] in a single transaction. A zero
+// CoinType transfers AptosCoinType.
+type BatchTransferCoinsRequest struct {
+	CoinType  models.TypeTag
+	Receivers []models.AccountAddress
+	Amounts   []uint64
+}
+
+// BatchTransfer calls 0x1::aptos_account::batch_transfer_coins.
+func (impl *CoinClientImpl) BatchTransfer(ctx context.Context, sender models.AccountSigner, req BatchTransferCoinsRequest) (string, error) {
+	if len(req.Receivers) != len(req.Amounts) {
+		return "", fmt.Errorf("%d receivers but %d amounts", len(req.Receivers), len(req.Amounts))
+	}
+
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "BatchTransferCoins", sender, models.EntryFunctionPayload{
+		Module:        AptosAccountModule,
+		Function:      "batch_transfer_coins",
+		TypeArguments: []models.TypeTag{coinTypeOrDefault(req.CoinType)},
+		Arguments:     []interface{}{req.Receivers, req.Amounts},
+	})
+}
+
+func (impl *CoinClientImpl) WaitForTransaction(ctx context.Context, txHash string, waitOpts WaitOptions) (*TransactionResp, error) {
+	return impl.client.WaitForTransactionWithOptions(ctx, txHash, waitOpts)
+}
+
+func coinTypeOrDefault(coinType models.TypeTag) models.TypeTag {
+	if coinType == nil {
+		return AptosCoinType
+	}
+	return coinType
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"crypto/ed25519"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/the729/lcs"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+func TestCoinClient(t *testing.T) {
+	owner, err := models.HexToAccountAddress(mockAddr)
+	assert.NoError(t, err)
+	receivers := []models.AccountAddress{{31: 2}, {31: 3}}
+	amounts := []uint64{10, 20}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var err error
+		switch req.URL.Path {
+		case "/v1":
+			_, err = w.Write([]byte(`{"chain_id":1}`))
+		case "/v1/accounts/" + mockAddr + "/resource/0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>":
+			_, err = w.Write([]byte(`{"type":"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>","data":{"coin":{"value":"1359005"},"frozen":false}}`))
+		case "/v1/accounts/" + mockAddr + "/resource/0x1::coin::CoinStore<0x2::coin::Missing>":
+			w.WriteHeader(http.StatusNotFound)
+			_, err = w.Write([]byte(`{"message":"Resource not found","error_code":"resource_not_found"}`))
+		case "/v1/accounts/0x1/resource/0x1::coin::CoinInfo<0x1::aptos_coin::AptosCoin>":
+			_, err = w.Write([]byte(`{"type":"0x1::coin::CoinInfo<0x1::aptos_coin::AptosCoin>","data":{"decimals":8,"name":"Aptos Coin","symbol":"APT","supply":{"vec":[]}}}`))
+		case "/v1/view":
+			_, err = w.Write([]byte(`[{"vec":["18446744073709551616"]}]`))
+		case "/v1/estimate_gas_price":
+			_, err = w.Write([]byte(`{"gas_estimate":100}`))
+		case "/v1/transactions":
+			var body []byte
+			body, err = io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			tx, decodeErr := models.DecodeUserTransactionBCS(body)
+			assert.NoError(t, decodeErr)
+
+			payload := tx.Payload.(models.EntryFunctionPayload)
+			assert.Equal(t, AptosAccountModule, payload.Module)
+			assert.Equal(t, "batch_transfer_coins", payload.Function)
+			assert.Equal(t, 1, len(payload.TypeArguments))
+			assert.Equal(t, "0x1::aptos_coin::AptosCoin", models.MoveTypeString(payload.TypeArguments[0]))
+			receiversBCS, _ := lcs.Marshal(receivers)
+			amountsBCS, _ := lcs.Marshal(amounts)
+			assert.Equal(t, [][]byte{receiversBCS, amountsBCS}, payload.ArgumentsBCS)
+
+			w.WriteHeader(http.StatusAccepted)
+			_, err = w.Write([]byte(`{"hash":"0x1"}`))
+		default:
+			_, err = w.Write([]byte(`{"sequence_number":"1","authentication_key":"0x1"}`))
+		}
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	coinClient, err := NewCoinClient(mockCTX, NewAptosClient(srv.URL))
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(1), coinClient.(*CoinClientImpl).chainID)
+
+	balance, err := coinClient.Balance(mockCTX, owner, AptosCoinType)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1359005), balance)
+
+	_, err = coinClient.Balance(mockCTX, owner, models.TypeTagStruct{Address: models.AccountAddress{31: 2}, Module: "coin", Name: "Missing"})
+	if e, ok := err.(*Error); assert.True(t, ok) {
+		assert.True(t, e.HasErrorCode(ErrResourceNotFound))
+	}
+
+	info, err := coinClient.CoinInfo(mockCTX, AptosCoinType)
+	assert.NoError(t, err)
+	supply, _ := new(big.Int).SetString("18446744073709551616", 10)
+	assert.Equal(t, &CoinInfo{Name: "Aptos Coin", Symbol: "APT", Decimals: 8, Supply: supply}, info)
+
+	sender := models.NewSingleSigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+	hash, err := coinClient.BatchTransfer(mockCTX, &sender, BatchTransferCoinsRequest{
+		Receivers: receivers,
+		Amounts:   amounts,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1", hash)
+
+	_, err = coinClient.BatchTransfer(mockCTX, &sender, BatchTransferCoinsRequest{Receivers: receivers})
+	assert.Error(t, err)
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+// ledgerChainID returns the chain ID of the ledger client is connected to.
+func ledgerChainID(ctx context.Context, client AptosClient) (uint8, error) {
+	ledgerInfo, err := client.LedgerInformation(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return ledgerInfo.ChainID, nil
+}
+
+// submitEntryFunction builds a transaction of sender calling payload, signs and submits it, and returns
+// its hash. The building and signing steps are traced as children of the operation span.
+func submitEntryFunction(ctx context.Context, client AptosClient, chainID uint8, operation string,
+	sender models.AccountSigner, payload models.EntryFunctionPayload) (hash string, err error) {
+
+	tracer := tracerOf(client)
+	ctx, span := tracer.Start(ctx, operation)
+	defer func() {
+		if hash != "" {
+			span.SetAttributes(Attribute{Key: AttributeTransactionHash, Value: hash})
+		}
+		span.End(err)
+	}()
+
+	tx, err := buildEntryFunctionTransaction(ctx, tracer, client, chainID, sender.Address(), payload)
+	if err != nil {
+		return "", err
+	}
+
+	_, signSpan := tracer.Start(ctx, "SignTransaction")
+	err = sender.Sign(tx).Error()
+	signSpan.End(err)
+	if err != nil {
+		return "", fmt.Errorf("sign tx error: %v", err)
+	}
+
+	txResp, err := client.SubmitTransaction(ctx, tx.UserTransaction)
+	if err != nil {
+		return "", fmt.Errorf("submit tx error: %w", err)
+	}
+
+	return txResp.Hash, nil
+}
+
+func buildEntryFunctionTransaction(ctx context.Context, tracer Tracer, client AptosClient, chainID uint8,
+	sender models.AccountAddress, payload models.EntryFunctionPayload) (tx *models.Transaction, err error) {
+
+	ctx, span := tracer.Start(ctx, "BuildTransaction")
+	defer func() { span.End(err) }()
+
+	addr := sender.PrefixZeroTrimmedHex()
+
+	accountInfo, err := client.GetAccount(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("get account info error: %w", err)
+	}
+
+	gasPrice, err := client.EstimateGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get estimate gas price error: %w", err)
+	}
+
+	tx = &models.Transaction{}
+	err = tx.SetChainID(chainID).
+		SetSender(addr).
+		SetPayload(payload).
+		SetExpirationTimestampSecs(uint64(time.Now().Add(30 * time.Second).Unix())).
+		SetGasUnitPrice(gasPrice).
+		SetMaxGasAmount(DefaultMaxGasAmount).
+		SetSequenceNumber(accountInfo.SequenceNumber).Error()
+	if err != nil {
+		return nil, fmt.Errorf("build tx error: %v", err)
+	}
+
+	return tx, nil
+}
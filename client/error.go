@@ -1,24 +1,227 @@
 package client
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+// ErrorCode is the error_code of an Aptos API error. The ErrorCode constants can be matched against errors
+// returned by the clients with errors.Is.
+type ErrorCode string
+
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
+const (
+	ErrInternal                 ErrorCode = "internal_error"
+	ErrWebFramework             ErrorCode = "web_framework_error"
+	ErrInvalidInput             ErrorCode = "invalid_input"
+	ErrAccountNotFound          ErrorCode = "account_not_found"
+	ErrResourceNotFound         ErrorCode = "resource_not_found"
+	ErrModuleNotFound           ErrorCode = "module_not_found"
+	ErrStructFieldNotFound      ErrorCode = "struct_field_not_found"
+	ErrVersionNotFound          ErrorCode = "version_not_found"
+	ErrTransactionNotFound      ErrorCode = "transaction_not_found"
+	ErrTableItemNotFound        ErrorCode = "table_item_not_found"
+	ErrBlockNotFound            ErrorCode = "block_not_found"
+	ErrStateValueNotFound       ErrorCode = "state_value_not_found"
+	ErrVersionPruned            ErrorCode = "version_pruned"
+	ErrBlockPruned              ErrorCode = "block_pruned"
+	ErrInvalidTransactionUpdate ErrorCode = "invalid_transaction_update"
+	ErrSequenceNumberTooOld     ErrorCode = "sequence_number_too_old"
+	ErrVMError                  ErrorCode = "vm_error"
+	ErrHealthCheckFailed        ErrorCode = "health_check_failed"
+	ErrMempoolIsFull            ErrorCode = "mempool_is_full"
+	ErrAPIDisabled              ErrorCode = "api_disabled"
+)
+
+// VMErrorCode is the vm_error_code of an Aptos API error with the ErrVMError code, which is the status of the
+// transaction validation. The VMErrorCode constants can be matched with errors.Is.
+type VMErrorCode int
+
+func (c VMErrorCode) Error() string {
+	if name, ok := vmErrorCodeNames[c]; ok {
+		return fmt.Sprintf("vm_error_code %d (%s)", int(c), name)
+	}
+	return fmt.Sprintf("vm_error_code %d", int(c))
+}
+
+// Name returns the name of the status code in the Move VM, or an empty string if unknown.
+func (c VMErrorCode) Name() string {
+	return vmErrorCodeNames[c]
+}
 
 const (
-	ErrTableItemNotFound = "table_item_not_found"
-	ErrAccountNotFound   = "account_not_found"
-	ErrModuleNotFound    = "module_not_found"
+	ErrVMInvalidSignature          VMErrorCode = 1
+	ErrVMInvalidAuthKey            VMErrorCode = 2
+	ErrVMSequenceNumberTooOld      VMErrorCode = 3
+	ErrVMSequenceNumberTooNew      VMErrorCode = 4
+	ErrVMInsufficientBalanceForFee VMErrorCode = 5
+	ErrVMTransactionExpired        VMErrorCode = 6
+	ErrVMSendingAccountNotExist    VMErrorCode = 7
+	ErrVMBadChainID                VMErrorCode = 23
 )
 
+var vmErrorCodeNames = map[VMErrorCode]string{
+	ErrVMInvalidSignature:          "INVALID_SIGNATURE",
+	ErrVMInvalidAuthKey:            "INVALID_AUTH_KEY",
+	ErrVMSequenceNumberTooOld:      "SEQUENCE_NUMBER_TOO_OLD",
+	ErrVMSequenceNumberTooNew:      "SEQUENCE_NUMBER_TOO_NEW",
+	ErrVMInsufficientBalanceForFee: "INSUFFICIENT_BALANCE_FOR_TRANSACTION_FEE",
+	ErrVMTransactionExpired:        "TRANSACTION_EXPIRED",
+	ErrVMSendingAccountNotExist:    "SENDING_ACCOUNT_DOES_NOT_EXIST",
+	ErrVMBadChainID:                "BAD_CHAIN_ID",
+}
+
+// Error is returned when the node answers with an error status. Responses without a JSON error body only
+// carry the status code and the body as Message.
 type Error struct {
-	StatusCode  int    `json:"status_code"`
-	Message     string `json:"message"`
-	ErrorCode   string `json:"error_code"`
-	VMErrorCode int    `json:"vm_error_code"`
+	StatusCode  int       `json:"status_code"`
+	Message     string    `json:"message"`
+	ErrorCode   ErrorCode `json:"error_code"`
+	VMErrorCode int       `json:"vm_error_code"`
 }
 
 func (e *Error) Error() string {
+	if e.ErrorCode == "" {
+		return fmt.Sprintf("response(%d): %s", e.StatusCode, e.Message)
+	}
 	return fmt.Sprintf("%s: %s", e.ErrorCode, e.Message)
 }
 
-func (e *Error) IsErrorCode(code string) bool {
+// IsErrorCode reports whether the error_code of e is code.
+//
+// Deprecated: use HasErrorCode, or errors.Is with an ErrorCode.
+func (e *Error) IsErrorCode(code ErrorCode) bool {
+	return e.HasErrorCode(code)
+}
+
+// HasErrorCode reports whether the error_code of e is code.
+func (e *Error) HasErrorCode(code ErrorCode) bool {
 	return e.ErrorCode == code
 }
+
+// Is makes an Error match its ErrorCode, and its VMErrorCode for a vm_error, with errors.Is.
+func (e *Error) Is(target error) bool {
+	switch t := target.(type) {
+	case ErrorCode:
+		return e.ErrorCode == t
+	case VMErrorCode:
+		return e.ErrorCode == ErrVMError && VMErrorCode(e.VMErrorCode) == t
+	}
+	return false
+}
+
+// Retryable reports whether the call may succeed if sent again later: the node was rate limiting, overloaded
+// or failing, or its mempool was full.
+func (e *Error) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests ||
+		e.StatusCode >= http.StatusInternalServerError ||
+		e.ErrorCode == ErrMempoolIsFull
+}
+
+// IsRetryable reports whether a call which failed with err may succeed if sent again later, which is the case
+// of a retryable *Error and of transport errors. Context errors are not retryable.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Retryable()
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// RetryError is returned when a request still fails after being retried.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("failed after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// VersionPrunedError is returned when a call pinned to a ledger version with WithLedgerVersion fails because
+// the node pruned that version. OldestVersion is the oldest version the node still serves.
+type VersionPrunedError struct {
+	Version       uint64
+	OldestVersion uint64
+	Err           error
+}
+
+func (e *VersionPrunedError) Error() string {
+	return fmt.Sprintf("ledger version %d is pruned, oldest available version is %d: %v",
+		e.Version, e.OldestVersion, e.Err)
+}
+
+func (e *VersionPrunedError) Unwrap() error {
+	return e.Err
+}
+
+// BlockPrunedError is returned by a BlockFollower when the block it should read next was pruned by the node.
+// OldestHeight is the oldest block height the node still serves.
+type BlockPrunedError struct {
+	Height       uint64
+	OldestHeight uint64
+	Err          error
+}
+
+func (e *BlockPrunedError) Error() string {
+	return fmt.Sprintf("block height %d is pruned, oldest available height is %d: %v",
+		e.Height, e.OldestHeight, e.Err)
+}
+
+func (e *BlockPrunedError) Unwrap() error {
+	return e.Err
+}
+
+// TransactionFailedError is returned when a transaction was committed but its execution failed. VmStatus
+// describes the failure, e.g. a Move abort.
+type TransactionFailedError struct {
+	Hash        string
+	VmStatus    string
+	Transaction *TransactionResp
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("transaction %s failed: %s", e.Hash, e.VmStatus)
+}
+
+// Status returns the parsed VmStatus, which an AbortResolver can complete.
+func (e *TransactionFailedError) Status() models.VMStatus {
+	return models.ParseVMStatus(e.VmStatus)
+}
+
+// TransactionNotCommittedError is returned when waiting for a transaction stopped before it was committed.
+// Pending reports whether the node knew the transaction as pending.
+type TransactionNotCommittedError struct {
+	Hash    string
+	Pending bool
+	Err     error
+}
+
+func (e *TransactionNotCommittedError) Error() string {
+	state := "not found"
+	if e.Pending {
+		state = "pending"
+	}
+	return fmt.Sprintf("transaction %s is still %s: %v", e.Hash, state, e.Err)
+}
+
+func (e *TransactionNotCommittedError) Unwrap() error {
+	return e.Err
+}
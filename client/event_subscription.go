@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+// CheckpointStore persists the checkpoints of event subscriptions, which are the sequence numbers of the next
+// events to deliver. Implementations must be safe for concurrent use.
+type CheckpointStore interface {
+	// Load returns the checkpoint saved under key, or ok as false if there is none.
+	Load(ctx context.Context, key string) (seq uint64, ok bool, err error)
+	// Save saves the checkpoint under key.
+	Save(ctx context.Context, key string, seq uint64) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore keeping the checkpoints in memory.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]uint64
+}
+
+// NewMemoryCheckpointStore creates an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]uint64)}
+}
+
+func (s *MemoryCheckpointStore) Load(ctx context.Context, key string) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seq, ok := s.checkpoints[key]
+	return seq, ok, nil
+}
+
+func (s *MemoryCheckpointStore) Save(ctx context.Context, key string, seq uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[key] = seq
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore keeping the checkpoints in a JSON file. The file is replaced
+// atomically on each save, so it is never left half written.
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointStore creates a FileCheckpointStore backed by the file at path, which is created on the
+// first save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+func (s *FileCheckpointStore) Load(ctx context.Context, key string) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	if err != nil {
+		return 0, false, err
+	}
+	seq, ok := checkpoints[key]
+	return seq, ok, nil
+}
+
+func (s *FileCheckpointStore) Save(ctx context.Context, key string, seq uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[key] = seq
+
+	b, err := json.Marshal(checkpoints)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("create checkpoint file error: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write checkpoint file error: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync checkpoint file error: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close checkpoint file error: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("rename checkpoint file error: %w", err)
+	}
+	return nil
+}
+
+func (s *FileCheckpointStore) read() (map[string]uint64, error) {
+	checkpoints := make(map[string]uint64)
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint file error: %w", err)
+	}
+	if err := json.Unmarshal(b, &checkpoints); err != nil {
+		return nil, fmt.Errorf("decode checkpoint file error: %w", err)
+	}
+	return checkpoints, nil
+}
+
+// EventSource identifies an event stream, either by the event handle field FieldName of the resource
+// HandleStruct, or by its CreationNumber.
+type EventSource struct {
+	Address        string
+	HandleStruct   string
+	FieldName      string
+	CreationNumber string
+}
+
+// Key returns the default checkpoint key of the source.
+func (s EventSource) Key() string {
+	if s.CreationNumber != "" {
+		return fmt.Sprintf("%s/%s", s.Address, s.CreationNumber)
+	}
+	return fmt.Sprintf("%s/%s/%s", s.Address, s.HandleStruct, s.FieldName)
+}
+
+// EventSubscriptionConfig configures an EventSubscription. Zero values use the defaults.
+type EventSubscriptionConfig struct {
+	// Store persists the checkpoint, a MemoryCheckpointStore by default.
+	Store CheckpointStore
+	// Key is the key of the checkpoint in Store, EventSource.Key by default.
+	Key string
+	// Start is the sequence number of the first event to deliver when Store has no checkpoint.
+	Start uint64
+	// PageSize is the number of events fetched per call, 100 by default.
+	PageSize uint64
+	// PollInterval is the delay between polls once all events were delivered, 1s by default.
+	PollInterval time.Duration
+}
+
+// EventSubscription polls an event stream and delivers its new events in sequence order. The consumer calls
+// Commit once it processed an event, so that a subscription created after a restart resumes right after it,
+// without missing or duplicating events.
+type EventSubscription struct {
+	client       Events
+	source       EventSource
+	opts         []RequestOption
+	store        CheckpointStore
+	key          string
+	start        uint64
+	pageSize     uint64
+	pollInterval time.Duration
+}
+
+// NewEventSubscription creates an EventSubscription to the events of source.
+func NewEventSubscription(client Events, source EventSource, cfg EventSubscriptionConfig,
+	opts ...RequestOption) *EventSubscription {
+
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryCheckpointStore()
+	}
+	if cfg.Key == "" {
+		cfg.Key = source.Key()
+	}
+	if cfg.PageSize == 0 {
+		cfg.PageSize = defaultIteratorPageSize
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultIteratorPollInterval
+	}
+
+	return &EventSubscription{
+		client:       client,
+		source:       source,
+		opts:         opts,
+		store:        cfg.Store,
+		key:          cfg.Key,
+		start:        cfg.Start,
+		pageSize:     cfg.PageSize,
+		pollInterval: cfg.PollInterval,
+	}
+}
+
+// Subscribe starts polling from the saved checkpoint in a goroutine and sends the new events on the returned
+// channel until ctx is done or a call fails. The error channel receives the error that stopped the
+// subscription; both channels are closed when it stops.
+func (s *EventSubscription) Subscribe(ctx context.Context) (<-chan models.Event, <-chan error) {
+	eventCh := make(chan models.Event)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(errCh)
+		defer close(eventCh)
+
+		if err := s.poll(ctx, eventCh); err != nil {
+			errCh <- err
+		}
+	}()
+
+	return eventCh, errCh
+}
+
+// Commit saves the checkpoint right after event, which must have been delivered by the subscription.
+func (s *EventSubscription) Commit(ctx context.Context, event models.Event) error {
+	return s.store.Save(ctx, s.key, uint64(event.SequenceNumber)+1)
+}
+
+func (s *EventSubscription) poll(ctx context.Context, eventCh chan<- models.Event) error {
+	next, ok, err := s.store.Load(ctx, s.key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		next = s.start
+	}
+
+	for {
+		events, err := s.fetch(ctx, next)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if uint64(event.SequenceNumber) < next {
+				continue
+			}
+			select {
+			case eventCh <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			next = uint64(event.SequenceNumber) + 1
+		}
+
+		if uint64(len(events)) < s.pageSize {
+			if err := sleepContext(ctx, s.pollInterval); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *EventSubscription) fetch(ctx context.Context, start uint64) ([]models.Event, error) {
+	if s.source.CreationNumber != "" {
+		return s.client.GetEventsByCreationNumber(ctx, s.source.Address, s.source.CreationNumber,
+			map[string]interface{}{
+				"start": start,
+				"limit": s.pageSize,
+			}, s.opts...)
+	}
+	return s.client.GetEventsByEventHandle(ctx, s.source.Address, s.source.HandleStruct, s.source.FieldName,
+		start, s.pageSize, s.opts...)
+}
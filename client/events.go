@@ -9,17 +9,17 @@ import (
 )
 
 type Events interface {
-	GetEventsByCreationNumber(ctx context.Context, address, creationNumber string, query map[string]interface{}, opts ...interface{}) ([]models.Event, error)
-	GetEventsByEventHandle(ctx context.Context, address, handleStruct, fieldName string, start, limit uint64, opts ...interface{}) ([]models.Event, error)
+	GetEventsByCreationNumber(ctx context.Context, address, creationNumber string, query map[string]interface{}, opts ...RequestOption) ([]models.Event, error)
+	GetEventsByEventHandle(ctx context.Context, address, handleStruct, fieldName string, start, limit uint64, opts ...RequestOption) ([]models.Event, error)
 }
 
 type EventsImpl struct {
 	Base
 }
 
-func (impl EventsImpl) GetEventsByCreationNumber(ctx context.Context, address, creationNumber string, query map[string]interface{}, opts ...interface{}) ([]models.Event, error) {
+func (impl EventsImpl) GetEventsByCreationNumber(ctx context.Context, address, creationNumber string, query map[string]interface{}, opts ...RequestOption) ([]models.Event, error) {
 	var rspJSON []models.Event
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetEventsByCreationNumber", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/events/%s", address, creationNumber),
 		nil, &rspJSON, query, requestOptions(opts...))
 	if err != nil {
@@ -29,9 +29,9 @@ func (impl EventsImpl) GetEventsByCreationNumber(ctx context.Context, address, c
 	return rspJSON, nil
 }
 
-func (impl EventsImpl) GetEventsByEventHandle(ctx context.Context, address, handleStruct, fieldName string, start, limit uint64, opts ...interface{}) ([]models.Event, error) {
+func (impl EventsImpl) GetEventsByEventHandle(ctx context.Context, address, handleStruct, fieldName string, start, limit uint64, opts ...RequestOption) ([]models.Event, error) {
 	var rspJSON []models.Event
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetEventsByEventHandle", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/events/%s/%s",
 			address, handleStruct, fieldName),
 		nil, &rspJSON, map[string]interface{}{
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+func TestEventSubscription(t *testing.T) {
+	var mu sync.Mutex
+	count := 2
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.Equal(t, "/v1/accounts/"+mockAddr+"/events/0x1::coin::CoinStore/deposit_events", req.URL.Path)
+		start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+		events := []models.Event{}
+		for seq := start; seq < count; seq++ {
+			events = append(events, models.Event{SequenceNumber: models.Uint64(seq)})
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(events))
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+	source := EventSource{Address: mockAddr, HandleStruct: "0x1::coin::CoinStore", FieldName: "deposit_events"}
+	cfg := EventSubscriptionConfig{
+		Store:        NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json")),
+		PollInterval: time.Millisecond,
+	}
+
+	sub := NewEventSubscription(c, source, cfg)
+	ctx, cancel := context.WithCancel(mockCTX)
+	events, errs := sub.Subscribe(ctx)
+	event := <-events
+	assert.Equal(t, models.Uint64(0), event.SequenceNumber)
+	assert.NoError(t, sub.Commit(ctx, event))
+	event = <-events
+	assert.Equal(t, models.Uint64(1), event.SequenceNumber)
+	// stop before event 1 is committed
+	cancel()
+	for range events {
+	}
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	seq, ok, err := cfg.Store.Load(mockCTX, source.Key())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), seq)
+
+	// a new subscription resumes at event 1 and waits for event 2
+	sub = NewEventSubscription(c, source, cfg)
+	ctx, cancel = context.WithCancel(mockCTX)
+	defer cancel()
+	events, _ = sub.Subscribe(ctx)
+	event = <-events
+	assert.Equal(t, models.Uint64(1), event.SequenceNumber)
+	mu.Lock()
+	count++
+	mu.Unlock()
+	event = <-events
+	assert.Equal(t, models.Uint64(2), event.SequenceNumber)
+}
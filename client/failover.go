@@ -0,0 +1,307 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FailoverConfig configures the node selection of NewFailoverClient.
+type FailoverConfig struct {
+	// HealthCheckInterval is the period between two probes of every node. Defaults to 10 seconds.
+	HealthCheckInterval time.Duration
+	// MaxLedgerLag is the number of versions a node may lag behind the highest known ledger version
+	// before it stops receiving requests. Defaults to 1000.
+	MaxLedgerLag uint64
+}
+
+// FailoverClient is an AptosClient spreading its calls over several fullnodes. Calls stick to one node
+// while it is healthy and fail over to another node on connection errors and 5xx responses. Calls which are
+// not idempotent, such as SubmitTransaction, are sent to a single node and never resent. Nodes are
+// probed with CheckBasicNodeHealth and LedgerInformation, and nodes lagging behind the highest known
+// ledger version are avoided. Calls pinned to a ledger version, such as the pages read by
+// WalkAccountResources after the first one, are sent to the nodes which reached that version first.
+// Close must be called to stop the probes.
+type FailoverClient struct {
+	AptosClient
+
+	transport *failoverTransport
+	cancel    context.CancelFunc
+	done      chan struct{}
+	// probed is closed once every node was probed once
+	probed chan struct{}
+}
+
+// NewFailoverClient creates a FailoverClient for the given endpoints. The options apply to every node. The
+// nodes are probed in the background, and are all considered healthy until then.
+func NewFailoverClient(endpoints []string, config FailoverConfig, opts ...Option) (*FailoverClient, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no endpoints")
+	}
+
+	if config.HealthCheckInterval <= 0 {
+		config.HealthCheckInterval = 10 * time.Second
+	}
+	if config.MaxLedgerLag == 0 {
+		config.MaxLedgerLag = 1000
+	}
+
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	base := o.buildHTTPClient().Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	transport := &failoverTransport{
+		base:   base,
+		maxLag: config.MaxLedgerLag,
+	}
+	for _, endpoint := range endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		transport.nodes = append(transport.nodes, &failoverNode{
+			endpoint: endpoint,
+			url:      u,
+			client:   NewAptosClient(endpoint, opts...),
+			healthy:  true,
+		})
+	}
+	transport.primary = transport.nodes[0].url
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &FailoverClient{
+		AptosClient: NewAptosClient(endpoints[0], append(opts[:len(opts):len(opts)], WithTransport(transport))...),
+		transport:   transport,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		probed:      make(chan struct{}),
+	}
+
+	go c.run(ctx, config.HealthCheckInterval)
+	return c, nil
+}
+
+func (c *FailoverClient) tracer() Tracer {
+	return tracerOf(c.AptosClient)
+}
+
+// Close stops the node probes.
+func (c *FailoverClient) Close() {
+	c.cancel()
+	<-c.done
+}
+
+func (c *FailoverClient) run(ctx context.Context, interval time.Duration) {
+	defer close(c.done)
+
+	c.transport.probe(ctx)
+	close(c.probed)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.transport.probe(ctx)
+		}
+	}
+}
+
+type failoverNode struct {
+	endpoint string
+	url      *url.URL
+	client   AptosClient
+
+	healthy       bool
+	ledgerVersion uint64
+}
+
+type failoverTransport struct {
+	base    http.RoundTripper
+	primary *url.URL
+	nodes   []*failoverNode
+	maxLag  uint64
+
+	mu      sync.Mutex
+	current *failoverNode
+	// highest is the highest ledger version reported by any node
+	highest uint64
+}
+
+// probe checks the health and the ledger version of every node concurrently.
+func (t *failoverTransport) probe(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, n := range t.nodes {
+		wg.Add(1)
+		go func(n *failoverNode) {
+			defer wg.Done()
+
+			healthy := true
+			var version uint64
+			if _, err := n.client.CheckBasicNodeHealth(ctx, 0); err != nil {
+				healthy = false
+			} else if info, err := n.client.LedgerInformation(ctx); err != nil {
+				healthy = false
+			} else if version, err = strconv.ParseUint(info.LedgerVersion, 10, 64); err != nil {
+				healthy = false
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			n.healthy = healthy
+			if healthy {
+				t.observe(n, version)
+			}
+		}(n)
+	}
+	wg.Wait()
+}
+
+// observe records a ledger version reported by n. t.mu must be held.
+func (t *failoverTransport) observe(n *failoverNode, version uint64) {
+	if version > n.ledgerVersion {
+		n.ledgerVersion = version
+	}
+	if version > t.highest {
+		t.highest = version
+	}
+}
+
+// candidates returns the nodes in the order they should be tried: the current node if it is usable, the
+// other usable nodes from the most to the least up to date, then the remaining nodes as a last resort. A node
+// is usable if it is healthy, not lagging and reached minVersion.
+func (t *failoverTransport) candidates(minVersion uint64) []*failoverNode {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	usable := func(n *failoverNode) bool {
+		return n.healthy && n.ledgerVersion+t.maxLag >= t.highest && n.ledgerVersion >= minVersion
+	}
+
+	nodes := make([]*failoverNode, len(t.nodes))
+	copy(nodes, t.nodes)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i], nodes[j]
+		if usable(a) != usable(b) {
+			return usable(a)
+		}
+		if (a == t.current) != (b == t.current) {
+			return a == t.current
+		}
+		return a.ledgerVersion > b.ledgerVersion
+	})
+	return nodes
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// calls pinned to a ledger version fail on the nodes which did not reach it yet
+	minVersion, _ := strconv.ParseUint(req.URL.Query().Get("ledger_version"), 10, 64)
+	candidates := t.candidates(minVersion)
+	// a call which may have been applied by a failed node must not be sent again
+	idempotent := isIdempotent(req.Method, req.URL.String())
+
+	var lastErr error
+	for i, n := range candidates {
+		last := i == len(candidates)-1 || !idempotent
+
+		r, err := t.rewrite(req, n)
+		if err != nil {
+			return nil, err
+		}
+
+		rsp, err := t.base.RoundTrip(r)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			t.markUnhealthy(n)
+			if last {
+				return nil, err
+			}
+			lastErr = err
+			continue
+		}
+
+		if rsp.StatusCode >= http.StatusInternalServerError {
+			t.markUnhealthy(n)
+			if !last {
+				discard(rsp)
+				continue
+			}
+			return rsp, nil
+		}
+
+		t.mu.Lock()
+		t.current = n
+		if version, ok := ledgerVersion(rsp.Header); ok {
+			t.observe(n, version)
+		}
+		t.mu.Unlock()
+		return rsp, nil
+	}
+	return nil, lastErr
+}
+
+// rewrite returns a copy of req addressed to n instead of the primary endpoint.
+func (t *failoverTransport) rewrite(req *http.Request, n *failoverNode) (*http.Request, error) {
+	r := req.Clone(req.Context())
+
+	u := *req.URL
+	u.Scheme = n.url.Scheme
+	u.Host = n.url.Host
+	u.Path = strings.TrimSuffix(n.url.Path, "/") + strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(t.primary.Path, "/"))
+	u.RawPath = ""
+	r.URL = &u
+	r.Host = ""
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+func (t *failoverTransport) markUnhealthy(n *failoverNode) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n.healthy = false
+	if t.current == n {
+		t.current = nil
+	}
+}
+
+func ledgerVersion(header http.Header) (uint64, bool) {
+	v := header.Get("X-Aptos-Ledger-Version")
+	if v == "" {
+		return 0, false
+	}
+	version, err := strconv.ParseUint(v, 10, 64)
+	return version, err == nil
+}
+
+func discard(rsp *http.Response) {
+	_, _ = io.Copy(io.Discard, rsp.Body)
+	rsp.Body.Close()
+}
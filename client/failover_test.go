@@ -0,0 +1,142 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockNode(t *testing.T, ledgerVersion uint64, status *int32) (*httptest.Server, *int32) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Aptos-Ledger-Version", fmt.Sprint(ledgerVersion))
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+
+		switch req.URL.Path {
+		case "/v1/-/healthy":
+			_, _ = w.Write([]byte(`{"message":"aptos-node:ok"}`))
+		case "/v1":
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"chain_id":1,"ledger_version":"%d"}`, ledgerVersion)))
+		default:
+			atomic.AddInt32(&calls, 1)
+			_, _ = w.Write([]byte(`{"sequence_number":"1","authentication_key":"0x1"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestFailoverClient(t *testing.T) {
+	t.Run("Failover", func(t *testing.T) {
+		status1, status2 := int32(http.StatusOK), int32(http.StatusOK)
+		srv1, calls1 := newMockNode(t, 100, &status1)
+		srv2, calls2 := newMockNode(t, 100, &status2)
+
+		c, err := NewFailoverClient([]string{srv1.URL, srv2.URL}, FailoverConfig{})
+		assert.NoError(t, err)
+		defer c.Close()
+		<-c.probed
+
+		_, err = c.GetAccount(mockCTX, mockAddr)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls1))
+
+		atomic.StoreInt32(&status1, http.StatusServiceUnavailable)
+		_, err = c.GetAccount(mockCTX, mockAddr)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls2))
+
+		// the failed node is avoided until it is probed again
+		atomic.StoreInt32(&status1, http.StatusOK)
+		_, err = c.GetAccount(mockCTX, mockAddr)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls2))
+	})
+
+	t.Run("NotIdempotent", func(t *testing.T) {
+		status1, status2 := int32(http.StatusOK), int32(http.StatusOK)
+		srv1, _ := newMockNode(t, 100, &status1)
+		srv2, calls2 := newMockNode(t, 100, &status2)
+
+		c, err := NewFailoverClient([]string{srv1.URL, srv2.URL}, FailoverConfig{})
+		assert.NoError(t, err)
+		defer c.Close()
+		<-c.probed
+
+		// a submission which failed on the first node may have been accepted, so it is not resent
+		atomic.StoreInt32(&status1, http.StatusServiceUnavailable)
+		req, err := http.NewRequest(http.MethodPost, srv1.URL+"/v1/transactions", strings.NewReader("tx"))
+		assert.NoError(t, err)
+		rsp, err := c.transport.RoundTrip(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, rsp.StatusCode)
+		assert.NoError(t, rsp.Body.Close())
+		assert.Equal(t, int32(0), atomic.LoadInt32(calls2))
+
+		// while idempotent calls still fail over
+		_, err = c.GetAccount(mockCTX, mockAddr)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls2))
+	})
+
+	t.Run("ConnectionError", func(t *testing.T) {
+		status := int32(http.StatusOK)
+		down := httptest.NewServer(http.NotFoundHandler())
+		down.Close()
+		srv, calls := newMockNode(t, 100, &status)
+
+		c, err := NewFailoverClient([]string{down.URL, srv.URL}, FailoverConfig{})
+		assert.NoError(t, err)
+		defer c.Close()
+		<-c.probed
+
+		_, err = c.GetAccount(mockCTX, mockAddr)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+	})
+
+	t.Run("Lagging", func(t *testing.T) {
+		status1, status2 := int32(http.StatusOK), int32(http.StatusOK)
+		srv1, calls1 := newMockNode(t, 100, &status1)
+		srv2, calls2 := newMockNode(t, 5000, &status2)
+
+		c, err := NewFailoverClient([]string{srv1.URL, srv2.URL}, FailoverConfig{MaxLedgerLag: 10})
+		assert.NoError(t, err)
+		defer c.Close()
+		<-c.probed
+
+		_, err = c.GetAccount(mockCTX, mockAddr)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), atomic.LoadInt32(calls1))
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls2))
+	})
+
+	t.Run("PinnedLedgerVersion", func(t *testing.T) {
+		status1, status2 := int32(http.StatusOK), int32(http.StatusOK)
+		srv1, calls1 := newMockNode(t, 100, &status1)
+		srv2, calls2 := newMockNode(t, 200, &status2)
+
+		c, err := NewFailoverClient([]string{srv1.URL, srv2.URL}, FailoverConfig{})
+		assert.NoError(t, err)
+		defer c.Close()
+		<-c.probed
+
+		_, err = c.GetAccount(mockCTX, mockAddr, WithLedgerVersion(150))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), atomic.LoadInt32(calls1))
+		assert.Equal(t, int32(1), atomic.LoadInt32(calls2))
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		_, err := NewFailoverClient(nil, FailoverConfig{})
+		assert.Error(t, err)
+	})
+}
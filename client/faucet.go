@@ -7,9 +7,9 @@ import (
 )
 
 // NewFaucetClient creates FaucetClient to create and fund accounts
-func NewFaucetClient(endpoint string, client AptosClient) *FaucetClient {
+func NewFaucetClient(endpoint string, client AptosClient, opts ...Option) *FaucetClient {
 	impl := &FaucetClient{
-		APIBase:     APIBase{endpoint: endpoint},
+		APIBase:     newAPIBase(endpoint, opts...),
 		aptosClient: client,
 	}
 	return impl
@@ -25,7 +25,7 @@ type FaucetClient struct {
 // FundAccount creates an account if it doesn't exist and mints the given amount of coins into account
 func (im *FaucetClient) FundAccount(ctx context.Context, address string, amount uint64) error {
 	var txHashes []string
-	err := request(ctx, http.MethodPost,
+	err := im.APIBase.request(ctx, "FundAccount", http.MethodPost,
 		im.APIBase.Endpoint()+"/mint",
 		nil, &txHashes, map[string]interface{}{
 			"address": address,
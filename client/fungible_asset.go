@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+type FungibleAssetClient interface {
+	// Balance returns the amount of the fungible asset of metadata in the primary store of owner, which is zero
+	// if the store does not exist.
+	Balance(ctx context.Context, owner, metadata models.AccountAddress, opts ...RequestOption) (uint64, error)
+	IsFrozen(ctx context.Context, owner, metadata models.AccountAddress, opts ...RequestOption) (bool, error)
+	Metadata(ctx context.Context, metadata models.AccountAddress, opts ...RequestOption) (*FungibleAssetMetadata, error)
+
+	Transfer(ctx context.Context, sender models.AccountSigner, req TransferFungibleAssetRequest) (string, error)
+}
+
+// NewFungibleAssetClient creates FungibleAssetClient to do things with fungible assets held in primary stores,
+// reading the chain ID of client with ctx.
+func NewFungibleAssetClient(ctx context.Context, client AptosClient) (FungibleAssetClient, error) {
+	chainID, err := ledgerChainID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FungibleAssetClientImpl{
+		client:  client,
+		chainID: chainID,
+	}, nil
+}
+
+type FungibleAssetClientImpl struct {
+	client  AptosClient
+	chainID uint8
+}
+
+var FungibleAssetModule models.Module
+var PrimaryFungibleStoreModule models.Module
+
+// FungibleAssetMetadataType is the type of the metadata objects of fungible assets,
+// 0x1::fungible_asset::Metadata.
+var FungibleAssetMetadataType models.TypeTagStruct
+
+func init() {
+	moduleAddr, _ := models.HexToAccountAddress("0x1")
+	FungibleAssetModule = models.Module{
+		Address: moduleAddr,
+		Name:    "fungible_asset",
+	}
+	PrimaryFungibleStoreModule = models.Module{
+		Address: moduleAddr,
+		Name:    "primary_fungible_store",
+	}
+	FungibleAssetMetadataType = models.TypeTagStruct{
+		Address: moduleAddr,
+		Module:  "fungible_asset",
+		Name:    "Metadata",
+	}
+}
+
+// FungibleAssetMetadata is the 0x1::fungible_asset::Metadata resource of a fungible asset.
+type FungibleAssetMetadata struct {
+	Name       string `json:"name"`
+	Symbol     string `json:"symbol"`
+	Decimals   uint8  `json:"decimals"`
+	IconURI    string `json:"icon_uri"`
+	ProjectURI string `json:"project_uri"`
+}
+
+// Balance calls the 0x1::primary_fungible_store::balance view function, which also counts the balances kept
+// in concurrent balance resources.
+func (impl *FungibleAssetClientImpl) Balance(ctx context.Context, owner, metadata models.AccountAddress, opts ...RequestOption) (uint64, error) {
+	return fungibleAssetBalance(ctx, impl.client, owner, metadata, opts...)
+}
+
+func (impl *FungibleAssetClientImpl) IsFrozen(ctx context.Context, owner, metadata models.AccountAddress, opts ...RequestOption) (bool, error) {
+	var frozen []bool
+	if err := impl.client.View(ctx, PrimaryFungibleStoreModule, "is_frozen",
+		[]models.TypeTag{FungibleAssetMetadataType}, []interface{}{owner, metadata}, &frozen, opts...); err != nil {
+		return false, err
+	}
+	if len(frozen) != 1 {
+		return false, fmt.Errorf("unexpected is_frozen result %v", frozen)
+	}
+
+	return frozen[0], nil
+}
+
+func (impl *FungibleAssetClientImpl) Metadata(ctx context.Context, metadata models.AccountAddress, opts ...RequestOption) (*FungibleAssetMetadata, error) {
+	var resource struct {
+		Data FungibleAssetMetadata `json:"data"`
+	}
+	if err := impl.client.GetResourceWithCustomType(
+		ctx, metadata.PrefixZeroTrimmedHex(), models.MoveTypeString(FungibleAssetMetadataType), &resource, opts...,
+	); err != nil {
+		return nil, err
+	}
+
+	return &resource.Data, nil
+}
+
+// TransferFungibleAssetRequest transfers Amount of the fungible asset of Metadata from the primary store of the
+// sender to the primary store of Receiver.
+type TransferFungibleAssetRequest struct {
+	Metadata models.AccountAddress
+	Receiver models.AccountAddress
+	Amount   uint64
+}
+
+func (impl *FungibleAssetClientImpl) Transfer(ctx context.Context, sender models.AccountSigner, req TransferFungibleAssetRequest) (string, error) {
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "TransferFungibleAsset", sender,
+		NewFungibleAssetTransferPayload(req.Metadata, req.Receiver, req.Amount))
+}
+
+// NewFungibleAssetTransferPayload returns the payload calling 0x1::primary_fungible_store::transfer, which
+// creates the primary store of receiver if needed.
+func NewFungibleAssetTransferPayload(metadata, receiver models.AccountAddress, amount uint64) models.EntryFunctionPayload {
+	return models.EntryFunctionPayload{
+		Module:        PrimaryFungibleStoreModule,
+		Function:      "transfer",
+		TypeArguments: []models.TypeTag{FungibleAssetMetadataType},
+		Arguments:     []interface{}{metadata, receiver, amount},
+	}
+}
+
+func fungibleAssetBalance(ctx context.Context, client Views, owner, metadata models.AccountAddress, opts ...RequestOption) (uint64, error) {
+	var balance []string
+	if err := client.View(ctx, PrimaryFungibleStoreModule, "balance",
+		[]models.TypeTag{FungibleAssetMetadataType}, []interface{}{owner, metadata}, &balance, opts...); err != nil {
+		return 0, err
+	}
+	if len(balance) != 1 {
+		return 0, fmt.Errorf("unexpected balance result %v", balance)
+	}
+
+	amount, err := strconv.ParseUint(balance[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse balance error: %w", err)
+	}
+
+	return amount, nil
+}
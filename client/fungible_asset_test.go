@@ -0,0 +1,75 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+func TestFungibleAsset(t *testing.T) {
+	owner, err := models.HexToAccountAddress(mockAddr)
+	assert.NoError(t, err)
+	metadata, err := models.HexToAccountAddress("0xa")
+	assert.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var err error
+		switch req.URL.Path {
+		case "/v1":
+			_, err = w.Write([]byte(`{"chain_id":1}`))
+		case "/v1/accounts/0xb/resource/0x1::fungible_asset::Metadata":
+			w.WriteHeader(http.StatusNotFound)
+			_, err = w.Write([]byte(`{"message":"Resource not found","error_code":"resource_not_found"}`))
+		case "/v1/accounts/" + mockAddr + "/resource/0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>":
+			_, err = w.Write([]byte(`{"type":"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>","data":{"coin":{"value":"100"},"frozen":false}}`))
+		case "/v1/accounts/0xa/resource/0x1::fungible_asset::Metadata":
+			_, err = w.Write([]byte(`{"type":"0x1::fungible_asset::Metadata","data":{"decimals":8,"icon_uri":"","name":"Aptos Coin","project_uri":"","symbol":"APT"}}`))
+		case "/v1/view":
+			var viewReq ViewRequest
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&viewReq))
+			switch viewReq.Function {
+			case "0x1::coin::paired_metadata":
+				assert.Equal(t, []string{"0x1::aptos_coin::AptosCoin"}, viewReq.TypeArguments)
+				_, err = w.Write([]byte(`[{"vec":[{"inner":"0xa"}]}]`))
+			case "0x1::primary_fungible_store::balance":
+				assert.Equal(t, []string{"0x1::fungible_asset::Metadata"}, viewReq.TypeArguments)
+				assert.Equal(t, []interface{}{mockAddr, metadata.ToHex()}, viewReq.Arguments)
+				_, err = w.Write([]byte(`["23"]`))
+			default:
+				t.Errorf("unexpected view function %s", viewReq.Function)
+			}
+		default:
+			t.Errorf("unexpected path %s", req.URL.Path)
+		}
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+	faClient, err := NewFungibleAssetClient(mockCTX, c)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(1), faClient.(*FungibleAssetClientImpl).chainID)
+	coinClient := &CoinClientImpl{client: c, chainID: 1}
+
+	balance, err := faClient.Balance(mockCTX, owner, metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(23), balance)
+
+	balance, err = coinClient.TotalBalance(mockCTX, owner, AptosCoinType)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(123), balance)
+
+	info, err := faClient.Metadata(mockCTX, metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, &FungibleAssetMetadata{Name: "Aptos Coin", Symbol: "APT", Decimals: 8}, info)
+
+	_, err = faClient.Metadata(mockCTX, models.AccountAddress{31: 0xb})
+	if e, ok := err.(*Error); assert.True(t, ok) {
+		assert.True(t, e.HasErrorCode(ErrResourceNotFound))
+	}
+}
@@ -6,8 +6,8 @@ import (
 )
 
 type General interface {
-	LedgerInformation(ctx context.Context, opts ...interface{}) (*LedgerInfo, error)
-	CheckBasicNodeHealth(ctx context.Context, durationSecs uint32, opts ...interface{}) (*HealthInfo, error)
+	LedgerInformation(ctx context.Context, opts ...RequestOption) (*LedgerInfo, error)
+	CheckBasicNodeHealth(ctx context.Context, durationSecs uint32, opts ...RequestOption) (*HealthInfo, error)
 }
 
 type GeneralImp struct {
@@ -25,9 +25,9 @@ type LedgerInfo struct {
 	NodeRole            string `json:"node_role"`
 }
 
-func (impl GeneralImp) LedgerInformation(ctx context.Context, opts ...interface{}) (*LedgerInfo, error) {
+func (impl GeneralImp) LedgerInformation(ctx context.Context, opts ...RequestOption) (*LedgerInfo, error) {
 	var rspJSON LedgerInfo
-	err := request(ctx, http.MethodGet, impl.Base.Endpoint()+"/v1", nil, &rspJSON, nil, requestOptions(opts...))
+	err := impl.Base.request(ctx, "LedgerInformation", http.MethodGet, impl.Base.Endpoint()+"/v1", nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
 		return nil, err
 	}
@@ -39,9 +39,9 @@ type HealthInfo struct {
 	Message string `json:"message"`
 }
 
-func (impl GeneralImp) CheckBasicNodeHealth(ctx context.Context, durationSecs uint32, opts ...interface{}) (*HealthInfo, error) {
+func (impl GeneralImp) CheckBasicNodeHealth(ctx context.Context, durationSecs uint32, opts ...RequestOption) (*HealthInfo, error) {
 	var rspJSON HealthInfo
-	err := request(ctx, http.MethodGet, impl.Base.Endpoint()+"/v1/-/healthy",
+	err := impl.Base.request(ctx, "CheckBasicNodeHealth", http.MethodGet, impl.Base.Endpoint()+"/v1/-/healthy",
 		nil, &rspJSON, map[string]interface{}{
 			"duration_secs": durationSecs,
 		}, requestOptions(opts...))
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// CallInfo describes the client call an Interceptor runs for. The same CallInfo is passed to every hook of
+// a call, so interceptors can correlate them, e.g. to measure latency.
+type CallInfo struct {
+	// Operation is the name of the client method, e.g. "GetAccountResources".
+	Operation string
+	Method    string
+	// Endpoint is the URL of the call before interceptors modify it, without query parameters.
+	Endpoint string
+	// Attempt is the number of the current attempt, starting at 1.
+	Attempt int
+	// StatusCode is the HTTP status of the last response, or 0 if none was received.
+	StatusCode int
+	Start      time.Time
+}
+
+// Interceptor observes and modifies the REST calls of AptosClient and FaucetClient. Request hooks run in
+// registration order and response and error hooks in reverse order, so the first registered interceptor
+// wraps all the others.
+type Interceptor interface {
+	// InterceptRequest runs before every attempt. It may modify req, e.g. add headers or rewrite the URL.
+	// Returning an error aborts the call without retrying it.
+	InterceptRequest(info *CallInfo, req *http.Request) error
+	// InterceptResponse runs for every response with its whole body, before the body is decoded.
+	// Returning an error aborts the call without retrying it.
+	InterceptResponse(info *CallInfo, rsp *http.Response, body []byte) error
+	// InterceptError runs once when the call fails and returns the error reported to the caller.
+	InterceptError(info *CallInfo, err error) error
+}
+
+// WithInterceptors appends interceptors to the ones of the client.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(o *clientOptions) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// InterceptorFuncs implements Interceptor with optional functions.
+type InterceptorFuncs struct {
+	Request  func(info *CallInfo, req *http.Request) error
+	Response func(info *CallInfo, rsp *http.Response, body []byte) error
+	Error    func(info *CallInfo, err error) error
+}
+
+func (f InterceptorFuncs) InterceptRequest(info *CallInfo, req *http.Request) error {
+	if f.Request == nil {
+		return nil
+	}
+	return f.Request(info, req)
+}
+
+func (f InterceptorFuncs) InterceptResponse(info *CallInfo, rsp *http.Response, body []byte) error {
+	if f.Response == nil {
+		return nil
+	}
+	return f.Response(info, rsp, body)
+}
+
+func (f InterceptorFuncs) InterceptError(info *CallInfo, err error) error {
+	if f.Error == nil {
+		return err
+	}
+	return f.Error(info, err)
+}
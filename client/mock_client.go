@@ -15,14 +15,18 @@ type MockAptosClient struct {
 }
 
 // CheckBasicNodeHealth provides a mock function with given fields: ctx, durationSecs, opts
-func (_m *MockAptosClient) CheckBasicNodeHealth(ctx context.Context, durationSecs uint32, opts ...interface{}) (*HealthInfo, error) {
+func (_m *MockAptosClient) CheckBasicNodeHealth(ctx context.Context, durationSecs uint32, opts ...RequestOption) (*HealthInfo, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, durationSecs)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *HealthInfo
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...interface{}) *HealthInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, ...RequestOption) *HealthInfo); ok {
 		r0 = rf(ctx, durationSecs, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -31,7 +35,7 @@ func (_m *MockAptosClient) CheckBasicNodeHealth(ctx context.Context, durationSec
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint32, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, ...RequestOption) error); ok {
 		r1 = rf(ctx, durationSecs, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -41,21 +45,25 @@ func (_m *MockAptosClient) CheckBasicNodeHealth(ctx context.Context, durationSec
 }
 
 // EstimateGasPrice provides a mock function with given fields: ctx, opts
-func (_m *MockAptosClient) EstimateGasPrice(ctx context.Context, opts ...interface{}) (uint64, error) {
+func (_m *MockAptosClient) EstimateGasPrice(ctx context.Context, opts ...RequestOption) (uint64, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context, ...interface{}) uint64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ...RequestOption) uint64); ok {
 		r0 = rf(ctx, opts...)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, ...RequestOption) error); ok {
 		r1 = rf(ctx, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -65,14 +73,18 @@ func (_m *MockAptosClient) EstimateGasPrice(ctx context.Context, opts ...interfa
 }
 
 // GetAccount provides a mock function with given fields: ctx, address, opts
-func (_m *MockAptosClient) GetAccount(ctx context.Context, address string, opts ...interface{}) (*AccountInfo, error) {
+func (_m *MockAptosClient) GetAccount(ctx context.Context, address string, opts ...RequestOption) (*AccountInfo, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *AccountInfo
-	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) *AccountInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...RequestOption) *AccountInfo); ok {
 		r0 = rf(ctx, address, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -81,7 +93,7 @@ func (_m *MockAptosClient) GetAccount(ctx context.Context, address string, opts
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -91,14 +103,18 @@ func (_m *MockAptosClient) GetAccount(ctx context.Context, address string, opts
 }
 
 // GetAccountModules provides a mock function with given fields: ctx, address, opts
-func (_m *MockAptosClient) GetAccountModules(ctx context.Context, address string, opts ...interface{}) ([]AccountModule, error) {
+func (_m *MockAptosClient) GetAccountModules(ctx context.Context, address string, opts ...RequestOption) ([]AccountModule, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 []AccountModule
-	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) []AccountModule); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...RequestOption) []AccountModule); ok {
 		r0 = rf(ctx, address, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -107,7 +123,7 @@ func (_m *MockAptosClient) GetAccountModules(ctx context.Context, address string
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -116,15 +132,56 @@ func (_m *MockAptosClient) GetAccountModules(ctx context.Context, address string
 	return r0, r1
 }
 
+// GetAccountModulesPage provides a mock function with given fields: ctx, address, start, limit, opts
+func (_m *MockAptosClient) GetAccountModulesPage(ctx context.Context, address string, start string, limit uint64, opts ...RequestOption) ([]AccountModule, string, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, address, start, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []AccountModule
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64, ...RequestOption) []AccountModule); ok {
+		r0 = rf(ctx, address, start, limit, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AccountModule)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint64, ...RequestOption) string); ok {
+		r1 = rf(ctx, address, start, limit, opts...)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, uint64, ...RequestOption) error); ok {
+		r2 = rf(ctx, address, start, limit, opts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAccountResources provides a mock function with given fields: ctx, address, opts
-func (_m *MockAptosClient) GetAccountResources(ctx context.Context, address string, opts ...interface{}) ([]AccountResource, error) {
+func (_m *MockAptosClient) GetAccountResources(ctx context.Context, address string, opts ...RequestOption) ([]AccountResource, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 []AccountResource
-	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) []AccountResource); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...RequestOption) []AccountResource); ok {
 		r0 = rf(ctx, address, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -133,7 +190,7 @@ func (_m *MockAptosClient) GetAccountResources(ctx context.Context, address stri
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -142,15 +199,56 @@ func (_m *MockAptosClient) GetAccountResources(ctx context.Context, address stri
 	return r0, r1
 }
 
+// GetAccountResourcesPage provides a mock function with given fields: ctx, address, start, limit, opts
+func (_m *MockAptosClient) GetAccountResourcesPage(ctx context.Context, address string, start string, limit uint64, opts ...RequestOption) ([]AccountResource, string, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, address, start, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []AccountResource
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64, ...RequestOption) []AccountResource); ok {
+		r0 = rf(ctx, address, start, limit, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AccountResource)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint64, ...RequestOption) string); ok {
+		r1 = rf(ctx, address, start, limit, opts...)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, uint64, ...RequestOption) error); ok {
+		r2 = rf(ctx, address, start, limit, opts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAccountTransactions provides a mock function with given fields: ctx, address, start, limit, opts
-func (_m *MockAptosClient) GetAccountTransactions(ctx context.Context, address string, start int, limit int, opts ...interface{}) ([]TransactionResp, error) {
+func (_m *MockAptosClient) GetAccountTransactions(ctx context.Context, address string, start int, limit int, opts ...RequestOption) ([]TransactionResp, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address, start, limit)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 []TransactionResp
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, ...interface{}) []TransactionResp); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, ...RequestOption) []TransactionResp); ok {
 		r0 = rf(ctx, address, start, limit, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -159,7 +257,7 @@ func (_m *MockAptosClient) GetAccountTransactions(ctx context.Context, address s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, start, limit, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -169,14 +267,18 @@ func (_m *MockAptosClient) GetAccountTransactions(ctx context.Context, address s
 }
 
 // GetBlocksByHeight provides a mock function with given fields: ctx, height, withTransactions, opts
-func (_m *MockAptosClient) GetBlocksByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...interface{}) (*Block, error) {
+func (_m *MockAptosClient) GetBlocksByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...RequestOption) (*Block, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, height, withTransactions)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *Block
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool, ...interface{}) *Block); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool, ...RequestOption) *Block); ok {
 		r0 = rf(ctx, height, withTransactions, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -185,7 +287,7 @@ func (_m *MockAptosClient) GetBlocksByHeight(ctx context.Context, height uint64,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, bool, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, bool, ...RequestOption) error); ok {
 		r1 = rf(ctx, height, withTransactions, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -195,14 +297,18 @@ func (_m *MockAptosClient) GetBlocksByHeight(ctx context.Context, height uint64,
 }
 
 // GetBlocksByVersion provides a mock function with given fields: ctx, version, withTransactions, opts
-func (_m *MockAptosClient) GetBlocksByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...interface{}) (*Block, error) {
+func (_m *MockAptosClient) GetBlocksByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...RequestOption) (*Block, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, version, withTransactions)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *Block
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool, ...interface{}) *Block); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool, ...RequestOption) *Block); ok {
 		r0 = rf(ctx, version, withTransactions, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -211,7 +317,7 @@ func (_m *MockAptosClient) GetBlocksByVersion(ctx context.Context, version uint6
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, bool, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, bool, ...RequestOption) error); ok {
 		r1 = rf(ctx, version, withTransactions, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -221,14 +327,18 @@ func (_m *MockAptosClient) GetBlocksByVersion(ctx context.Context, version uint6
 }
 
 // GetEventsByCreationNumber provides a mock function with given fields: ctx, address, creationNumber, query, opts
-func (_m *MockAptosClient) GetEventsByCreationNumber(ctx context.Context, address string, creationNumber string, query map[string]interface{}, opts ...interface{}) ([]models.Event, error) {
+func (_m *MockAptosClient) GetEventsByCreationNumber(ctx context.Context, address string, creationNumber string, query map[string]interface{}, opts ...RequestOption) ([]models.Event, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address, creationNumber, query)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 []models.Event
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]interface{}, ...interface{}) []models.Event); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]interface{}, ...RequestOption) []models.Event); ok {
 		r0 = rf(ctx, address, creationNumber, query, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -237,7 +347,7 @@ func (_m *MockAptosClient) GetEventsByCreationNumber(ctx context.Context, addres
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, map[string]interface{}, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, map[string]interface{}, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, creationNumber, query, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -247,14 +357,18 @@ func (_m *MockAptosClient) GetEventsByCreationNumber(ctx context.Context, addres
 }
 
 // GetEventsByEventHandle provides a mock function with given fields: ctx, address, handleStruct, fieldName, start, limit, opts
-func (_m *MockAptosClient) GetEventsByEventHandle(ctx context.Context, address string, handleStruct string, fieldName string, start uint64, limit uint64, opts ...interface{}) ([]models.Event, error) {
+func (_m *MockAptosClient) GetEventsByEventHandle(ctx context.Context, address string, handleStruct string, fieldName string, start uint64, limit uint64, opts ...RequestOption) ([]models.Event, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address, handleStruct, fieldName, start, limit)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 []models.Event
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64, uint64, ...interface{}) []models.Event); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64, uint64, ...RequestOption) []models.Event); ok {
 		r0 = rf(ctx, address, handleStruct, fieldName, start, limit, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -263,7 +377,7 @@ func (_m *MockAptosClient) GetEventsByEventHandle(ctx context.Context, address s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint64, uint64, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uint64, uint64, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, handleStruct, fieldName, start, limit, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -273,14 +387,18 @@ func (_m *MockAptosClient) GetEventsByEventHandle(ctx context.Context, address s
 }
 
 // GetModuleByModuleID provides a mock function with given fields: ctx, address, moduleID, opts
-func (_m *MockAptosClient) GetModuleByModuleID(ctx context.Context, address string, moduleID string, opts ...interface{}) (*AccountModule, error) {
+func (_m *MockAptosClient) GetModuleByModuleID(ctx context.Context, address string, moduleID string, opts ...RequestOption) (*AccountModule, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address, moduleID)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *AccountModule
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...interface{}) *AccountModule); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...RequestOption) *AccountModule); ok {
 		r0 = rf(ctx, address, moduleID, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -289,7 +407,7 @@ func (_m *MockAptosClient) GetModuleByModuleID(ctx context.Context, address stri
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, moduleID, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -299,14 +417,18 @@ func (_m *MockAptosClient) GetModuleByModuleID(ctx context.Context, address stri
 }
 
 // GetResourceByAccountAddressAndResourceType provides a mock function with given fields: ctx, address, resourceType, opts
-func (_m *MockAptosClient) GetResourceByAccountAddressAndResourceType(ctx context.Context, address string, resourceType string, opts ...interface{}) (*AccountResource, error) {
+func (_m *MockAptosClient) GetResourceByAccountAddressAndResourceType(ctx context.Context, address string, resourceType string, opts ...RequestOption) (*AccountResource, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address, resourceType)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *AccountResource
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...interface{}) *AccountResource); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...RequestOption) *AccountResource); ok {
 		r0 = rf(ctx, address, resourceType, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -315,7 +437,7 @@ func (_m *MockAptosClient) GetResourceByAccountAddressAndResourceType(ctx contex
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...RequestOption) error); ok {
 		r1 = rf(ctx, address, resourceType, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -325,14 +447,18 @@ func (_m *MockAptosClient) GetResourceByAccountAddressAndResourceType(ctx contex
 }
 
 // GetResourceWithCustomType provides a mock function with given fields: ctx, address, resourceType, resp, opts
-func (_m *MockAptosClient) GetResourceWithCustomType(ctx context.Context, address string, resourceType string, resp interface{}, opts ...interface{}) error {
+func (_m *MockAptosClient) GetResourceWithCustomType(ctx context.Context, address string, resourceType string, resp interface{}, opts ...RequestOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, address, resourceType, resp)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, interface{}, ...interface{}) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, interface{}, ...RequestOption) error); ok {
 		r0 = rf(ctx, address, resourceType, resp, opts...)
 	} else {
 		r0 = ret.Error(0)
@@ -342,14 +468,18 @@ func (_m *MockAptosClient) GetResourceWithCustomType(ctx context.Context, addres
 }
 
 // GetTableItemByHandleAndKey provides a mock function with given fields: ctx, handle, req, resp, opts
-func (_m *MockAptosClient) GetTableItemByHandleAndKey(ctx context.Context, handle string, req TableItemReq, resp interface{}, opts ...interface{}) error {
+func (_m *MockAptosClient) GetTableItemByHandleAndKey(ctx context.Context, handle string, req TableItemReq, resp interface{}, opts ...RequestOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, handle, req, resp)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, TableItemReq, interface{}, ...interface{}) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, TableItemReq, interface{}, ...RequestOption) error); ok {
 		r0 = rf(ctx, handle, req, resp, opts...)
 	} else {
 		r0 = ret.Error(0)
@@ -359,14 +489,18 @@ func (_m *MockAptosClient) GetTableItemByHandleAndKey(ctx context.Context, handl
 }
 
 // GetTransactionByHash provides a mock function with given fields: ctx, txHash, opts
-func (_m *MockAptosClient) GetTransactionByHash(ctx context.Context, txHash string, opts ...interface{}) (*TransactionResp, error) {
+func (_m *MockAptosClient) GetTransactionByHash(ctx context.Context, txHash string, opts ...RequestOption) (*TransactionResp, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, txHash)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *TransactionResp
-	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) *TransactionResp); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...RequestOption) *TransactionResp); ok {
 		r0 = rf(ctx, txHash, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -375,7 +509,7 @@ func (_m *MockAptosClient) GetTransactionByHash(ctx context.Context, txHash stri
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...RequestOption) error); ok {
 		r1 = rf(ctx, txHash, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -385,14 +519,18 @@ func (_m *MockAptosClient) GetTransactionByHash(ctx context.Context, txHash stri
 }
 
 // GetTransactionByVersion provides a mock function with given fields: ctx, version, opts
-func (_m *MockAptosClient) GetTransactionByVersion(ctx context.Context, version uint64, opts ...interface{}) (*TransactionResp, error) {
+func (_m *MockAptosClient) GetTransactionByVersion(ctx context.Context, version uint64, opts ...RequestOption) (*TransactionResp, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, version)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *TransactionResp
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, ...interface{}) *TransactionResp); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, ...RequestOption) *TransactionResp); ok {
 		r0 = rf(ctx, version, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -401,7 +539,7 @@ func (_m *MockAptosClient) GetTransactionByVersion(ctx context.Context, version
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, ...RequestOption) error); ok {
 		r1 = rf(ctx, version, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -411,14 +549,18 @@ func (_m *MockAptosClient) GetTransactionByVersion(ctx context.Context, version
 }
 
 // GetTransactions provides a mock function with given fields: ctx, start, limit, opts
-func (_m *MockAptosClient) GetTransactions(ctx context.Context, start int, limit int, opts ...interface{}) ([]TransactionResp, error) {
+func (_m *MockAptosClient) GetTransactions(ctx context.Context, start int, limit int, opts ...RequestOption) ([]TransactionResp, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, start, limit)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 []TransactionResp
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...interface{}) []TransactionResp); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) []TransactionResp); ok {
 		r0 = rf(ctx, start, limit, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -427,7 +569,187 @@ func (_m *MockAptosClient) GetTransactions(ctx context.Context, start int, limit
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, start, limit, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTypedAccountTransactions provides a mock function with given fields: ctx, address, start, limit, opts
+func (_m *MockAptosClient) GetTypedAccountTransactions(ctx context.Context, address string, start int, limit int, opts ...RequestOption) ([]TransactionVariant, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, address, start, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []TransactionVariant
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, ...RequestOption) []TransactionVariant); ok {
+		r0 = rf(ctx, address, start, limit, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TransactionVariant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, ...RequestOption) error); ok {
+		r1 = rf(ctx, address, start, limit, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTypedBlockByHeight provides a mock function with given fields: ctx, height, withTransactions, opts
+func (_m *MockAptosClient) GetTypedBlockByHeight(ctx context.Context, height uint64, withTransactions bool, opts ...RequestOption) (*TypedBlock, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, height, withTransactions)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *TypedBlock
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool, ...RequestOption) *TypedBlock); ok {
+		r0 = rf(ctx, height, withTransactions, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TypedBlock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, bool, ...RequestOption) error); ok {
+		r1 = rf(ctx, height, withTransactions, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTypedBlockByVersion provides a mock function with given fields: ctx, version, withTransactions, opts
+func (_m *MockAptosClient) GetTypedBlockByVersion(ctx context.Context, version uint64, withTransactions bool, opts ...RequestOption) (*TypedBlock, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, version, withTransactions)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *TypedBlock
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool, ...RequestOption) *TypedBlock); ok {
+		r0 = rf(ctx, version, withTransactions, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TypedBlock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, bool, ...RequestOption) error); ok {
+		r1 = rf(ctx, version, withTransactions, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTypedTransactionByHash provides a mock function with given fields: ctx, txHash, opts
+func (_m *MockAptosClient) GetTypedTransactionByHash(ctx context.Context, txHash string, opts ...RequestOption) (TransactionVariant, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, txHash)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 TransactionVariant
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...RequestOption) TransactionVariant); ok {
+		r0 = rf(ctx, txHash, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TransactionVariant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...RequestOption) error); ok {
+		r1 = rf(ctx, txHash, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTypedTransactionByVersion provides a mock function with given fields: ctx, version, opts
+func (_m *MockAptosClient) GetTypedTransactionByVersion(ctx context.Context, version uint64, opts ...RequestOption) (TransactionVariant, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 TransactionVariant
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, ...RequestOption) TransactionVariant); ok {
+		r0 = rf(ctx, version, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(TransactionVariant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, ...RequestOption) error); ok {
+		r1 = rf(ctx, version, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTypedTransactions provides a mock function with given fields: ctx, start, limit, opts
+func (_m *MockAptosClient) GetTypedTransactions(ctx context.Context, start int, limit int, opts ...RequestOption) ([]TransactionVariant, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, start, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []TransactionVariant
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...RequestOption) []TransactionVariant); ok {
+		r0 = rf(ctx, start, limit, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TransactionVariant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...RequestOption) error); ok {
 		r1 = rf(ctx, start, limit, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -437,14 +759,18 @@ func (_m *MockAptosClient) GetTransactions(ctx context.Context, start int, limit
 }
 
 // LedgerInformation provides a mock function with given fields: ctx, opts
-func (_m *MockAptosClient) LedgerInformation(ctx context.Context, opts ...interface{}) (*LedgerInfo, error) {
+func (_m *MockAptosClient) LedgerInformation(ctx context.Context, opts ...RequestOption) (*LedgerInfo, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *LedgerInfo
-	if rf, ok := ret.Get(0).(func(context.Context, ...interface{}) *LedgerInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ...RequestOption) *LedgerInfo); ok {
 		r0 = rf(ctx, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -453,7 +779,7 @@ func (_m *MockAptosClient) LedgerInformation(ctx context.Context, opts ...interf
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, ...RequestOption) error); ok {
 		r1 = rf(ctx, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -463,14 +789,18 @@ func (_m *MockAptosClient) LedgerInformation(ctx context.Context, opts ...interf
 }
 
 // SimulateTransaction provides a mock function with given fields: ctx, tx, estimateGasUnitPrice, estimateMaxGasAmount, opts
-func (_m *MockAptosClient) SimulateTransaction(ctx context.Context, tx models.UserTransaction, estimateGasUnitPrice bool, estimateMaxGasAmount bool, opts ...interface{}) ([]TransactionResp, error) {
+func (_m *MockAptosClient) SimulateTransaction(ctx context.Context, tx models.UserTransaction, estimateGasUnitPrice bool, estimateMaxGasAmount bool, opts ...RequestOption) ([]TransactionResp, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, tx, estimateGasUnitPrice, estimateMaxGasAmount)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 []TransactionResp
-	if rf, ok := ret.Get(0).(func(context.Context, models.UserTransaction, bool, bool, ...interface{}) []TransactionResp); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserTransaction, bool, bool, ...RequestOption) []TransactionResp); ok {
 		r0 = rf(ctx, tx, estimateGasUnitPrice, estimateMaxGasAmount, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -479,7 +809,7 @@ func (_m *MockAptosClient) SimulateTransaction(ctx context.Context, tx models.Us
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.UserTransaction, bool, bool, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, models.UserTransaction, bool, bool, ...RequestOption) error); ok {
 		r1 = rf(ctx, tx, estimateGasUnitPrice, estimateMaxGasAmount, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -489,14 +819,18 @@ func (_m *MockAptosClient) SimulateTransaction(ctx context.Context, tx models.Us
 }
 
 // SubmitTransaction provides a mock function with given fields: ctx, tx, opts
-func (_m *MockAptosClient) SubmitTransaction(ctx context.Context, tx models.UserTransaction, opts ...interface{}) (*TransactionResp, error) {
+func (_m *MockAptosClient) SubmitTransaction(ctx context.Context, tx models.UserTransaction, opts ...RequestOption) (*TransactionResp, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
 	var _ca []interface{}
 	_ca = append(_ca, ctx, tx)
-	_ca = append(_ca, opts...)
+	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 *TransactionResp
-	if rf, ok := ret.Get(0).(func(context.Context, models.UserTransaction, ...interface{}) *TransactionResp); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserTransaction, ...RequestOption) *TransactionResp); ok {
 		r0 = rf(ctx, tx, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -505,7 +839,7 @@ func (_m *MockAptosClient) SubmitTransaction(ctx context.Context, tx models.User
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, models.UserTransaction, ...interface{}) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, models.UserTransaction, ...RequestOption) error); ok {
 		r1 = rf(ctx, tx, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -514,6 +848,27 @@ func (_m *MockAptosClient) SubmitTransaction(ctx context.Context, tx models.User
 	return r0, r1
 }
 
+// View provides a mock function with given fields: ctx, module, function, typeArgs, args, resp, opts
+func (_m *MockAptosClient) View(ctx context.Context, module models.Module, function string, typeArgs []models.TypeTag, args []interface{}, resp interface{}, opts ...RequestOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, module, function, typeArgs, args, resp)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Module, string, []models.TypeTag, []interface{}, interface{}, ...RequestOption) error); ok {
+		r0 = rf(ctx, module, function, typeArgs, args, resp, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitForTransaction provides a mock function with given fields: ctx, txHash
 func (_m *MockAptosClient) WaitForTransaction(ctx context.Context, txHash string) error {
 	ret := _m.Called(ctx, txHash)
@@ -528,6 +883,36 @@ func (_m *MockAptosClient) WaitForTransaction(ctx context.Context, txHash string
 	return r0
 }
 
+// WaitForTransactionWithOptions provides a mock function with given fields: ctx, txHash, waitOpts, opts
+func (_m *MockAptosClient) WaitForTransactionWithOptions(ctx context.Context, txHash string, waitOpts WaitOptions, opts ...RequestOption) (*TransactionResp, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, txHash, waitOpts)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *TransactionResp
+	if rf, ok := ret.Get(0).(func(context.Context, string, WaitOptions, ...RequestOption) *TransactionResp); ok {
+		r0 = rf(ctx, txHash, waitOpts, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TransactionResp)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, WaitOptions, ...RequestOption) error); ok {
+		r1 = rf(ctx, txHash, waitOpts, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMockAptosClient interface {
 	mock.TestingT
 	Cleanup(func())
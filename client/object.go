@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+type ObjectClient interface {
+	GetObjectCore(ctx context.Context, object models.AccountAddress, opts ...RequestOption) (*models.ObjectCore, error)
+
+	Transfer(ctx context.Context, owner models.AccountSigner, req TransferObjectRequest) (string, error)
+}
+
+// NewObjectClient creates ObjectClient to do things with aptos objects, reading the chain ID of client with ctx.
+func NewObjectClient(ctx context.Context, client AptosClient) (ObjectClient, error) {
+	chainID, err := ledgerChainID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectClientImpl{
+		client:  client,
+		chainID: chainID,
+	}, nil
+}
+
+type ObjectClientImpl struct {
+	client  AptosClient
+	chainID uint8
+}
+
+var ObjectModule models.Module
+
+// ObjectCoreType is the type of the resource every object has, 0x1::object::ObjectCore.
+var ObjectCoreType models.TypeTagStruct
+
+func init() {
+	moduleAddr, _ := models.HexToAccountAddress("0x1")
+	ObjectModule = models.Module{
+		Address: moduleAddr,
+		Name:    "object",
+	}
+	ObjectCoreType = models.TypeTagStruct{
+		Address: moduleAddr,
+		Module:  "object",
+		Name:    "ObjectCore",
+	}
+}
+
+// GetObjectCore reads the owner and transfer permission of object. It fails with ErrResourceNotFound if
+// object is not an object address.
+func (impl *ObjectClientImpl) GetObjectCore(ctx context.Context, object models.AccountAddress, opts ...RequestOption) (*models.ObjectCore, error) {
+	var resource struct {
+		Data models.ObjectCore `json:"data"`
+	}
+	if err := impl.client.GetResourceWithCustomType(
+		ctx, object.PrefixZeroTrimmedHex(), models.MoveTypeString(ObjectCoreType), &resource, opts...,
+	); err != nil {
+		return nil, err
+	}
+
+	return &resource.Data, nil
+}
+
+// TransferObjectRequest transfers Object to Receiver.
+type TransferObjectRequest struct {
+	Object   models.AccountAddress
+	Receiver models.AccountAddress
+}
+
+func (impl *ObjectClientImpl) Transfer(ctx context.Context, owner models.AccountSigner, req TransferObjectRequest) (string, error) {
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "TransferObject", owner,
+		NewObjectTransferPayload(req.Object, req.Receiver))
+}
+
+// NewObjectTransferPayload returns the payload calling 0x1::object::transfer, which the owner of object can
+// send if the object allows ungated transfers.
+func NewObjectTransferPayload(object, receiver models.AccountAddress) models.EntryFunctionPayload {
+	return models.EntryFunctionPayload{
+		Module:        ObjectModule,
+		Function:      "transfer",
+		TypeArguments: []models.TypeTag{ObjectCoreType},
+		Arguments:     []interface{}{object, receiver},
+	}
+}
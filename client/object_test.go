@@ -0,0 +1,83 @@
+package client
+
+import (
+	"crypto/ed25519"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/the729/lcs"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+func TestObjectClient(t *testing.T) {
+	owner, err := models.HexToAccountAddress(mockAddr)
+	assert.NoError(t, err)
+	object := models.AccountAddress{31: 0xa}
+	receiver := models.AccountAddress{31: 2}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var err error
+		switch req.URL.Path {
+		case "/v1":
+			_, err = w.Write([]byte(`{"chain_id":1}`))
+		case "/v1/accounts/0xa/resource/0x1::object::ObjectCore":
+			_, err = w.Write([]byte(`{"type":"0x1::object::ObjectCore","data":{"allow_ungated_transfer":true,"guid_creation_num":"1125899906842625","owner":"` + mockAddr + `","transfer_events":{"counter":"0","guid":{"id":{"addr":"0xa","creation_num":"1125899906842624"}}}}}`))
+		case "/v1/accounts/0xb/resource/0x1::object::ObjectCore":
+			w.WriteHeader(http.StatusNotFound)
+			_, err = w.Write([]byte(`{"message":"Resource not found","error_code":"resource_not_found"}`))
+		case "/v1/estimate_gas_price":
+			_, err = w.Write([]byte(`{"gas_estimate":100}`))
+		case "/v1/transactions":
+			var body []byte
+			body, err = io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			tx, decodeErr := models.DecodeUserTransactionBCS(body)
+			assert.NoError(t, decodeErr)
+
+			payload := tx.Payload.(models.EntryFunctionPayload)
+			assert.Equal(t, ObjectModule, payload.Module)
+			assert.Equal(t, "transfer", payload.Function)
+			assert.Equal(t, 1, len(payload.TypeArguments))
+			assert.Equal(t, "0x1::object::ObjectCore", models.MoveTypeString(payload.TypeArguments[0]))
+			objectBCS, _ := lcs.Marshal(object)
+			receiverBCS, _ := lcs.Marshal(receiver)
+			assert.Equal(t, [][]byte{objectBCS, receiverBCS}, payload.ArgumentsBCS)
+
+			w.WriteHeader(http.StatusAccepted)
+			_, err = w.Write([]byte(`{"hash":"0x1"}`))
+		default:
+			_, err = w.Write([]byte(`{"sequence_number":"1","authentication_key":"0x1"}`))
+		}
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	objectClient, err := NewObjectClient(mockCTX, NewAptosClient(srv.URL))
+	assert.NoError(t, err)
+
+	core, err := objectClient.GetObjectCore(mockCTX, object)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.ObjectCore{
+		GUIDCreationNum:      1125899906842625,
+		Owner:                mockAddr,
+		AllowUngatedTransfer: true,
+	}, core)
+	ownerAddr, err := core.OwnerAddress()
+	assert.NoError(t, err)
+	assert.Equal(t, owner, ownerAddr)
+
+	_, err = objectClient.GetObjectCore(mockCTX, models.AccountAddress{31: 0xb})
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+
+	sender := models.NewSingleSigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+	hash, err := objectClient.Transfer(mockCTX, &sender, TransferObjectRequest{
+		Object:   object,
+		Receiver: receiver,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "0x1", hash)
+}
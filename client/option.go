@@ -0,0 +1,110 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// defaultHTTPClient is shared by the clients created without WithHTTPClient, WithTransport or
+// WithClientTimeout, and by an APIBase that was not created through newAPIBase.
+var defaultHTTPClient = &http.Client{
+	Timeout: defaultTimeout,
+}
+
+// Option configures the clients created by NewAptosClient and NewFaucetClient.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	httpClient *http.Client
+	transport  http.RoundTripper
+	timeout    time.Duration
+	headers    http.Header
+	userAgent  string
+
+	retryPolicy  RetryPolicy
+	rateLimiter  *RateLimiter
+	interceptors []Interceptor
+	tracer       Tracer
+}
+
+// WithHTTPClient makes the client send requests through a copy of c instead of a default http.Client.
+func WithHTTPClient(c *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = c
+	}
+}
+
+// WithTransport sets the http.RoundTripper used by the client, e.g. to configure proxies or TLS.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(o *clientOptions) {
+		o.transport = rt
+	}
+}
+
+// WithTimeout sets the timeout of the requests sent by the clients created without WithHTTPClient,
+// WithTransport or WithClientTimeout. It must be called before sending requests.
+//
+// Deprecated: use WithClientTimeout, which sets the timeout of a single client.
+func WithTimeout(timeout time.Duration) {
+	defaultHTTPClient.Timeout = timeout
+}
+
+// WithClientTimeout sets the timeout of every request sent by the client. The default is 30 seconds.
+func WithClientTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithHeader adds a header to every request sent by the client, e.g. an API key of a hosted node provider.
+func WithHeader(key, value string) Option {
+	return func(o *clientOptions) {
+		if o.headers == nil {
+			o.headers = make(http.Header)
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request sent by the client.
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+func (o clientOptions) buildHTTPClient() *http.Client {
+	if o.httpClient == nil && o.transport == nil && o.timeout <= 0 {
+		return defaultHTTPClient
+	}
+
+	httpClient := &http.Client{
+		Timeout: defaultHTTPClient.Timeout,
+	}
+	if o.httpClient != nil {
+		c := *o.httpClient
+		httpClient = &c
+	}
+
+	if o.transport != nil {
+		httpClient.Transport = o.transport
+	}
+
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
+	}
+	return httpClient
+}
+
+func (o clientOptions) buildHeaders() http.Header {
+	headers := o.headers.Clone()
+	if o.userAgent != "" {
+		if headers == nil {
+			headers = make(http.Header)
+		}
+		headers.Set("User-Agent", o.userAgent)
+	}
+	return headers
+}
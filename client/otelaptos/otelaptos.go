@@ -0,0 +1,161 @@
+// Package otelaptos instruments the clients of package client with OpenTelemetry.
+//
+// Every instrumented call produces a span and records its latency in the aptos.client.duration histogram.
+// Failed calls are also counted by the aptos.client.errors counter, labeled with their error class.
+//
+//	tracer, err := otelaptos.NewTracer()
+//	if err != nil {
+//		return err
+//	}
+//	aptosClient := client.NewAptosClient(endpoint, client.WithTracer(tracer))
+//
+// Without a configured OpenTelemetry SDK the global providers are no-ops.
+//
+// otelaptos is a separate module, so that only its users depend on OpenTelemetry. It requires
+// github.com/portto/aptos-go-sdk v0.1.0 or later:
+//
+//	go get github.com/portto/aptos-go-sdk/client/otelaptos
+package otelaptos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/portto/aptos-go-sdk/client"
+)
+
+const instrumentationName = "github.com/portto/aptos-go-sdk/client/otelaptos"
+
+const attributeOperation = "aptos.operation"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures NewTracer.
+type Option func(*config)
+
+// WithTracerProvider sets the provider of the tracer. The global provider is used by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the provider of the meter. The global provider is used by default.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// NewTracer creates a client.Tracer recording spans and metrics with OpenTelemetry.
+func NewTracer(opts ...Option) (client.Tracer, error) {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	meter := c.meterProvider.Meter(instrumentationName)
+	duration, err := meter.Float64Histogram("aptos.client.duration",
+		metric.WithDescription("Duration of Aptos client operations"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return nil, fmt.Errorf("create duration histogram error: %w", err)
+	}
+
+	errors, err := meter.Int64Counter("aptos.client.errors",
+		metric.WithDescription("Number of failed Aptos client operations"))
+	if err != nil {
+		return nil, fmt.Errorf("create errors counter error: %w", err)
+	}
+
+	return &tracer{
+		tracer:   c.tracerProvider.Tracer(instrumentationName),
+		duration: duration,
+		errors:   errors,
+	}, nil
+}
+
+type tracer struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+func (t *tracer) Start(ctx context.Context, operation string) (context.Context, client.Span) {
+	ctx, s := t.tracer.Start(ctx, operation, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &span{
+		ctx:       ctx,
+		tracer:    t,
+		span:      s,
+		operation: operation,
+		start:     time.Now(),
+	}
+}
+
+type span struct {
+	ctx        context.Context
+	tracer     *tracer
+	span       trace.Span
+	operation  string
+	errorClass string
+	start      time.Time
+}
+
+func (s *span) SetAttributes(attrs ...client.Attribute) {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		if attr.Key == client.AttributeErrorClass {
+			s.errorClass = fmt.Sprint(attr.Value)
+		}
+		kvs = append(kvs, keyValue(attr))
+	}
+	s.span.SetAttributes(kvs...)
+}
+
+func (s *span) End(err error) {
+	op := attribute.String(attributeOperation, s.operation)
+	s.tracer.duration.Record(s.ctx, float64(time.Since(s.start))/float64(time.Millisecond), metric.WithAttributes(op))
+
+	if err != nil {
+		class := s.errorClass
+		if class == "" {
+			class = client.ErrorClassOther
+		}
+		s.tracer.errors.Add(s.ctx, 1, metric.WithAttributes(op, attribute.String(client.AttributeErrorClass, class)))
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
+
+func keyValue(attr client.Attribute) attribute.KeyValue {
+	switch v := attr.Value.(type) {
+	case string:
+		return attribute.String(attr.Key, v)
+	case bool:
+		return attribute.Bool(attr.Key, v)
+	case int:
+		return attribute.Int(attr.Key, v)
+	case int64:
+		return attribute.Int64(attr.Key, v)
+	case uint64:
+		return attribute.Int64(attr.Key, int64(v))
+	case float64:
+		return attribute.Float64(attr.Key, v)
+	default:
+		return attribute.String(attr.Key, fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,75 @@
+package otelaptos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/portto/aptos-go-sdk/client"
+)
+
+const mockAddr = "0xa4a793df771b6f48af4d9dbbe35ef2137a5ff0d7217ac5cc14544e4f30522a78"
+
+func TestTracer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/v1" {
+			_, err := w.Write([]byte(`{"chain_id":1}`))
+			assert.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"message":"Account not found","error_code":"account_not_found"}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	tracer, err := NewTracer(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	c := client.NewAptosClient(srv.URL, client.WithTracer(tracer))
+	_, err = c.LedgerInformation(ctx)
+	assert.NoError(t, err)
+	_, err = c.GetAccount(ctx, mockAddr)
+	assert.Error(t, err)
+
+	ended := spans.Ended()
+	assert.Equal(t, 2, len(ended))
+	assert.Equal(t, "LedgerInformation", ended[0].Name())
+	assert.Equal(t, codes.Unset, ended[0].Status().Code)
+	assert.Equal(t, "GetAccount", ended[1].Name())
+	assert.Equal(t, codes.Error, ended[1].Status().Code)
+	assert.Contains(t, ended[1].Attributes(), attribute.String(client.AttributeErrorCode, "account_not_found"))
+
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(ctx, &rm))
+	assert.Equal(t, 1, len(rm.ScopeMetrics))
+	metrics := make(map[string]metricdata.Metrics)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+
+	duration := metrics["aptos.client.duration"].Data.(metricdata.Histogram[float64])
+	assert.Equal(t, 2, len(duration.DataPoints))
+
+	errors := metrics["aptos.client.errors"].Data.(metricdata.Sum[int64])
+	assert.Equal(t, 1, len(errors.DataPoints))
+	assert.Equal(t, int64(1), errors.DataPoints[0].Value)
+	class, ok := errors.DataPoints[0].Attributes.Value(client.AttributeErrorClass)
+	assert.True(t, ok)
+	assert.Equal(t, client.ErrorClassClient, class.AsString())
+}
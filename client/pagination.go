@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+)
+
+func pageQuery(start string, limit uint64) map[string]interface{} {
+	query := make(map[string]interface{})
+	if start != "" {
+		query["start"] = start
+	}
+	if limit > 0 {
+		query["limit"] = limit
+	}
+	return query
+}
+
+// copyResponseHeader fills the ResponseHeader given in opts, if any, with header.
+func copyResponseHeader(opts []RequestOption, header ResponseHeader) {
+	if cfg := requestOptions(opts...); cfg.respHeader != nil {
+		*cfg.respHeader = header
+	}
+}
+
+// pinLedgerVersion returns opts pinned to the ledger version of header unless they already set one, so that the
+// following pages are read from the same state as the first one.
+func pinLedgerVersion(opts []RequestOption, header ResponseHeader) []RequestOption {
+	if requestOptions(opts...).ledgerVersion != nil {
+		return opts
+	}
+	return append(opts[:len(opts):len(opts)], WithLedgerVersion(header.AptosLedgerVersion))
+}
+
+// WalkAccountResources reads all the resources of address page by page, calling fn with each page of at most
+// limit resources. All pages are read at the ledger version of the first one unless opts set a ledger version.
+// It stops at the first error, including one returned by fn.
+func WalkAccountResources(ctx context.Context, client Accounts, address string, limit uint64,
+	fn func(resources []AccountResource) error, opts ...RequestOption) error {
+
+	var header ResponseHeader
+	opts = append(opts[:len(opts):len(opts)], &header)
+	cursor := ""
+	for page := 0; ; page++ {
+		resources, next, err := client.GetAccountResourcesPage(ctx, address, cursor, limit, opts...)
+		if err != nil {
+			return err
+		}
+		if err := fn(resources); err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+		if page == 0 {
+			opts = pinLedgerVersion(opts, header)
+		}
+		cursor = next
+	}
+}
+
+// WalkAccountModules reads all the modules of address page by page, calling fn with each page of at most limit
+// modules. All pages are read at the ledger version of the first one unless opts set a ledger version.
+func WalkAccountModules(ctx context.Context, client Accounts, address string, limit uint64,
+	fn func(modules []AccountModule) error, opts ...RequestOption) error {
+
+	var header ResponseHeader
+	opts = append(opts[:len(opts):len(opts)], &header)
+	cursor := ""
+	for page := 0; ; page++ {
+		modules, next, err := client.GetAccountModulesPage(ctx, address, cursor, limit, opts...)
+		if err != nil {
+			return err
+		}
+		if err := fn(modules); err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+		if page == 0 {
+			opts = pinLedgerVersion(opts, header)
+		}
+		cursor = next
+	}
+}
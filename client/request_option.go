@@ -0,0 +1,84 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestOption configures a single call of the client. A *ResponseHeader is a RequestOption which
+// captures the Aptos headers of the response.
+type RequestOption interface {
+	applyRequestOption(cfg *requestConfig)
+}
+
+type requestConfig struct {
+	respHeader    *ResponseHeader
+	ledgerVersion *uint64
+	timeout       time.Duration
+	headers       http.Header
+	bcs           *[]byte
+	bcsRequest    bool
+}
+
+func requestOptions(opts ...RequestOption) *requestConfig {
+	cfg := &requestConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.applyRequestOption(cfg)
+		}
+	}
+	return cfg
+}
+
+func (h *ResponseHeader) applyRequestOption(cfg *requestConfig) {
+	cfg.respHeader = h
+}
+
+type requestOptionFunc func(cfg *requestConfig)
+
+func (f requestOptionFunc) applyRequestOption(cfg *requestConfig) {
+	f(cfg)
+}
+
+// WithLedgerVersion reads the state at the given ledger version instead of the latest one. It applies to
+// the endpoints accepting a ledger_version query parameter. If the node has pruned the version, the call
+// fails with a *VersionPrunedError.
+func WithLedgerVersion(version uint64) RequestOption {
+	return requestOptionFunc(func(cfg *requestConfig) {
+		cfg.ledgerVersion = &version
+	})
+}
+
+// WithRequestTimeout bounds the duration of the call, including retries.
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return requestOptionFunc(func(cfg *requestConfig) {
+		cfg.timeout = timeout
+	})
+}
+
+// WithRequestHeader adds a header to the request of the call.
+func WithRequestHeader(key, value string) RequestOption {
+	return requestOptionFunc(func(cfg *requestConfig) {
+		if cfg.headers == nil {
+			cfg.headers = make(http.Header)
+		}
+		cfg.headers.Add(key, value)
+	})
+}
+
+// WithBCS asks the node for a BCS encoded response and stores its raw bytes in dst. The decoded result
+// of the call is left empty; the models.Decode*BCS functions decode the responses of the accounts,
+// resources, modules, transactions and blocks endpoints.
+func WithBCS(dst *[]byte) RequestOption {
+	return requestOptionFunc(func(cfg *requestConfig) {
+		cfg.bcs = dst
+	})
+}
+
+// WithBCSRequest sends the request body of the calls supporting it, such as View, encoded with BCS instead
+// of JSON.
+func WithBCSRequest() RequestOption {
+	return requestOptionFunc(func(cfg *requestConfig) {
+		cfg.bcsRequest = true
+	})
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+var ResourceAccountModule models.Module
+
+func init() {
+	moduleAddr, _ := models.HexToAccountAddress("0x1")
+	ResourceAccountModule = models.Module{
+		Address: moduleAddr,
+		Name:    "resource_account",
+	}
+}
+
+// IsResourceAccount reports whether address is a resource account created by origin with the
+// 0x1::resource_account module, from the 0x1::resource_account::Container resource of origin. Resource
+// accounts whose signer capability was already retrieved, e.g. by the package published under them, are no
+// longer listed in the container and are reported as false.
+func IsResourceAccount(ctx context.Context, client Accounts, origin, address string, opts ...RequestOption) (bool, error) {
+	addr, err := models.HexToAccountAddress(address)
+	if err != nil {
+		return false, fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	var container struct {
+		Data struct {
+			Store struct {
+				Data []struct {
+					Key string `json:"key"`
+				} `json:"data"`
+			} `json:"store"`
+		} `json:"data"`
+	}
+	err = client.GetResourceWithCustomType(ctx, origin, "0x1::resource_account::Container", &container, opts...)
+	if errors.Is(err, ErrResourceNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	for _, entry := range container.Data.Store.Data {
+		if key, err := models.HexToAccountAddress(entry.Key); err == nil && key == addr {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// NewCreateResourceAccountPayload returns the payload creating the resource account of seed. The
+// authentication key of the account is set to optionalAuthKey, or to the one of the sender if empty.
+func NewCreateResourceAccountPayload(seed, optionalAuthKey []byte) models.EntryFunctionPayload {
+	return models.EntryFunctionPayload{
+		Module:    ResourceAccountModule,
+		Function:  "create_resource_account",
+		Arguments: []interface{}{seed, optionalAuthKey},
+	}
+}
+
+// NewCreateResourceAccountAndFundPayload is like NewCreateResourceAccountPayload and also transfers fundAmount
+// of AptosCoin to the resource account.
+func NewCreateResourceAccountAndFundPayload(seed, optionalAuthKey []byte, fundAmount uint64) models.EntryFunctionPayload {
+	return models.EntryFunctionPayload{
+		Module:    ResourceAccountModule,
+		Function:  "create_resource_account_and_fund",
+		Arguments: []interface{}{seed, optionalAuthKey, fundAmount},
+	}
+}
+
+// NewCreateResourceAccountAndPublishPackagePayload returns the payload creating the resource account of seed
+// and publishing the package of metadataSerialized and code under it. The authentication key of the account is
+// set to zero, so that only the package can use its signer capability.
+func NewCreateResourceAccountAndPublishPackagePayload(seed, metadataSerialized []byte, code [][]byte) models.EntryFunctionPayload {
+	return models.EntryFunctionPayload{
+		Module:    ResourceAccountModule,
+		Function:  "create_resource_account_and_publish_package",
+		Arguments: []interface{}{seed, metadataSerialized, code},
+	}
+}
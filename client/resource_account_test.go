@@ -0,0 +1,56 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+func TestIsResourceAccount(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1/accounts/0xb0b/resource/0x1::resource_account::Container" {
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"message":"Resource not found","error_code":"resource_not_found"}`))
+			assert.NoError(t, err)
+			return
+		}
+		_, err := w.Write([]byte(`{"type":"0x1::resource_account::Container","data":{"store":{"data":[
+			{"key":"0xee89f8c763c27f9d942d496c1a0dcf32d5eacfe78416f9486b8db66155b163b0","value":{"account":"0xee89f8c763c27f9d942d496c1a0dcf32d5eacfe78416f9486b8db66155b163b0"}}
+		]}}}`))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+	ok, err := IsResourceAccount(mockCTX, c, "0xb0b", "0xee89f8c763c27f9d942d496c1a0dcf32d5eacfe78416f9486b8db66155b163b0")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = IsResourceAccount(mockCTX, c, "0xb0b", mockAddr)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = IsResourceAccount(mockCTX, c, mockAddr, mockAddr)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestResourceAccountPayload(t *testing.T) {
+	tx := &models.Transaction{}
+	err := tx.SetPayload(NewCreateResourceAccountAndPublishPackagePayload([]byte("seed"), []byte{0x01},
+		[][]byte{{0xa1, 0x1c}, {0xeb}})).Error()
+	assert.NoError(t, err)
+
+	payload := tx.Payload.(models.EntryFunctionPayload)
+	assert.Equal(t, ResourceAccountModule, payload.Module)
+	assert.Equal(t, "create_resource_account_and_publish_package", payload.Function)
+	assert.Equal(t, [][]byte{
+		{0x04, 's', 'e', 'e', 'd'},
+		{0x01, 0x01},
+		{0x02, 0x02, 0xa1, 0x1c, 0x01, 0xeb},
+	}, payload.ArgumentsBCS)
+}
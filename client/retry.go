@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Only idempotent requests are retried: every GET and
+// the read-only POST endpoints (transaction simulation, table item lookups and view functions). A request is retried when no
+// response was received or the node answered with 429 or a 5xx status.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt. Defaults to 200ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, except for delays requested by Retry-After. Defaults to 10s.
+	MaxBackoff time.Duration
+	// Multiplier is the growth factor of the delay after each attempt. Defaults to 2.
+	Multiplier float64
+	// Jitter randomizes each delay by up to the given fraction, e.g. 0.2 for ±20%.
+	Jitter float64
+}
+
+// DefaultRetryPolicy is a reasonable policy for read-heavy workloads against public fullnodes.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// WithRetryPolicy enables retries of idempotent requests. Retries are disabled by default.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// backoff returns the delay to wait after the given failed attempt, starting at 1.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = 200 * time.Millisecond
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = 10 * time.Second
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	d := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+	if p.Jitter > 0 {
+		d *= 1 - p.Jitter + 2*p.Jitter*rand.Float64()
+	}
+	if d > float64(max) {
+		d = float64(max)
+	}
+	return time.Duration(d)
+}
+
+// readOnlyPaths lists POST endpoints which do not change the chain state and are safe to retry.
+var readOnlyPaths = []string{
+	"/transactions/simulate",
+	"/item",
+	"/raw_item",
+	"/view",
+}
+
+func isIdempotent(method, endpoint string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return false
+		}
+		for _, path := range readOnlyPaths {
+			if strings.HasSuffix(u.Path, path) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// shouldRetry reports whether an attempt which ended with statusCode and err is worth retrying.
+// A zero statusCode means no response was received, which is retried for transport errors only.
+func shouldRetry(ctx context.Context, statusCode int, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Retryable()
+	}
+	if statusCode == 0 {
+		var urlErr *url.Error
+		return errors.As(err, &urlErr)
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -7,7 +7,7 @@ import (
 )
 
 type State interface {
-	GetTableItemByHandleAndKey(ctx context.Context, handle string, req TableItemReq, resp interface{}, opts ...interface{}) error
+	GetTableItemByHandleAndKey(ctx context.Context, handle string, req TableItemReq, resp interface{}, opts ...RequestOption) error
 }
 
 type StateImpl struct {
@@ -20,8 +20,8 @@ type TableItemReq struct {
 	Key       interface{} `json:"key"`
 }
 
-func (impl StateImpl) GetTableItemByHandleAndKey(ctx context.Context, handle string, req TableItemReq, resp interface{}, opts ...interface{}) error {
-	err := request(ctx, http.MethodPost,
+func (impl StateImpl) GetTableItemByHandleAndKey(ctx context.Context, handle string, req TableItemReq, resp interface{}, opts ...RequestOption) error {
+	err := impl.Base.request(ctx, "GetTableItemByHandleAndKey", http.MethodPost,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/tables/%s/item", handle),
 		req, resp, nil, requestOptions(opts...))
 	if err != nil {
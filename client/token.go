@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/hasura/go-graphql-client"
 
@@ -73,47 +72,12 @@ type CreateCollectionRequest struct {
 }
 
 func (impl *TokenClientImpl) CreateCollection(ctx context.Context, creator models.SingleSigner, req CreateCollectionRequest) (string, error) {
-	tx := models.Transaction{}
-
-	addr := creator.AccountAddress.PrefixZeroTrimmedHex()
-
-	accountInfo, err := impl.client.GetAccount(ctx, addr)
-	if err != nil {
-		return "", fmt.Errorf("get account info error: %w", err)
-	}
-
-	gasPrice, err := impl.client.EstimateGasPrice(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get estimate gas price error: %w", err)
-	}
-
-	err = tx.SetChainID(impl.chainID).
-		SetSender(addr).
-		SetPayload(models.EntryFunctionPayload{
-			Module:   TokenModule,
-			Function: "create_collection_script",
-			Arguments: []interface{}{req.Name, req.Description, req.URI, req.Maximum,
-				[]bool{req.MutateConfig.Description, req.MutateConfig.Maximum, req.MutateConfig.URI}},
-		}).
-		SetExpirationTimestampSecs(uint64(time.Now().Add(30 * time.Second).Unix())).
-		SetGasUnitPrice(gasPrice).
-		SetMaxGasAmount(DefaultMaxGasAmount).
-		SetSequenceNumber(accountInfo.SequenceNumber).Error()
-
-	if err != nil {
-		return "", fmt.Errorf("build tx error: %v", err)
-	}
-
-	if err := creator.Sign(&tx).Error(); err != nil {
-		return "", fmt.Errorf("sign tx error: %v", err)
-	}
-
-	txResp, err := impl.client.SubmitTransaction(ctx, tx.UserTransaction)
-	if err != nil {
-		return "", fmt.Errorf("submit tx error: %w", err)
-	}
-
-	return txResp.Hash, nil
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "CreateCollection", &creator, models.EntryFunctionPayload{
+		Module:   TokenModule,
+		Function: "create_collection_script",
+		Arguments: []interface{}{req.Name, req.Description, req.URI, req.Maximum,
+			[]bool{req.MutateConfig.Description, req.MutateConfig.Maximum, req.MutateConfig.URI}},
+	})
 }
 
 type CreateTokenRequest struct {
@@ -133,52 +97,17 @@ type CreateTokenRequest struct {
 }
 
 func (impl *TokenClientImpl) CreateToken(ctx context.Context, creator models.SingleSigner, req CreateTokenRequest) (string, error) {
-	tx := models.Transaction{}
-
-	addr := creator.AccountAddress.PrefixZeroTrimmedHex()
-
-	accountInfo, err := impl.client.GetAccount(ctx, addr)
-	if err != nil {
-		return "", fmt.Errorf("get account info error: %w", err)
-	}
-
-	gasPrice, err := impl.client.EstimateGasPrice(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get estimate gas price error: %w", err)
-	}
-
-	err = tx.SetChainID(impl.chainID).
-		SetSender(addr).
-		SetPayload(models.EntryFunctionPayload{
-			Module:   TokenModule,
-			Function: "create_token_script",
-			Arguments: []interface{}{
-				req.Collection, req.Name, req.Description, req.Supply, req.Maximum, req.URI,
-				req.RoyaltyPayeeAddress, req.RoyaltyPointsDenominator, req.RoyaltyPointsNumerator,
-				[]bool{req.MutateConfig.Maximum, req.MutateConfig.URI, req.MutateConfig.Description,
-					req.MutateConfig.Royalty, req.MutateConfig.Properties},
-				req.PropertyKeys, req.PropertyValues, req.PropertyTypes,
-			},
-		}).
-		SetExpirationTimestampSecs(uint64(time.Now().Add(30 * time.Second).Unix())).
-		SetGasUnitPrice(gasPrice).
-		SetMaxGasAmount(DefaultMaxGasAmount).
-		SetSequenceNumber(accountInfo.SequenceNumber).Error()
-
-	if err != nil {
-		return "", fmt.Errorf("build tx error: %v", err)
-	}
-
-	if err := creator.Sign(&tx).Error(); err != nil {
-		return "", fmt.Errorf("sign tx error: %v", err)
-	}
-
-	txResp, err := impl.client.SubmitTransaction(ctx, tx.UserTransaction)
-	if err != nil {
-		return "", fmt.Errorf("submit tx error: %w", err)
-	}
-
-	return txResp.Hash, nil
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "CreateToken", &creator, models.EntryFunctionPayload{
+		Module:   TokenModule,
+		Function: "create_token_script",
+		Arguments: []interface{}{
+			req.Collection, req.Name, req.Description, req.Supply, req.Maximum, req.URI,
+			req.RoyaltyPayeeAddress, req.RoyaltyPointsDenominator, req.RoyaltyPointsNumerator,
+			[]bool{req.MutateConfig.Maximum, req.MutateConfig.URI, req.MutateConfig.Description,
+				req.MutateConfig.Royalty, req.MutateConfig.Properties},
+			req.PropertyKeys, req.PropertyValues, req.PropertyTypes,
+		},
+	})
 }
 
 type MintTokenRequest struct {
@@ -189,46 +118,11 @@ type MintTokenRequest struct {
 }
 
 func (impl *TokenClientImpl) MintToken(ctx context.Context, minter models.SingleSigner, req MintTokenRequest) (string, error) {
-	tx := models.Transaction{}
-
-	addr := minter.AccountAddress.PrefixZeroTrimmedHex()
-
-	accountInfo, err := impl.client.GetAccount(ctx, addr)
-	if err != nil {
-		return "", fmt.Errorf("get account info error: %w", err)
-	}
-
-	gasPrice, err := impl.client.EstimateGasPrice(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get estimate gas price error: %w", err)
-	}
-
-	err = tx.SetChainID(impl.chainID).
-		SetSender(addr).
-		SetPayload(models.EntryFunctionPayload{
-			Module:    TokenModule,
-			Function:  "mint_script",
-			Arguments: []interface{}{req.Creator, req.Collection, req.TokenName, req.Amount},
-		}).
-		SetExpirationTimestampSecs(uint64(time.Now().Add(30 * time.Second).Unix())).
-		SetGasUnitPrice(gasPrice).
-		SetMaxGasAmount(DefaultMaxGasAmount).
-		SetSequenceNumber(accountInfo.SequenceNumber).Error()
-
-	if err != nil {
-		return "", fmt.Errorf("build tx error: %v", err)
-	}
-
-	if err := minter.Sign(&tx).Error(); err != nil {
-		return "", fmt.Errorf("sign tx error: %v", err)
-	}
-
-	txResp, err := impl.client.SubmitTransaction(ctx, tx.UserTransaction)
-	if err != nil {
-		return "", fmt.Errorf("submit tx error: %w", err)
-	}
-
-	return txResp.Hash, nil
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "MintToken", &minter, models.EntryFunctionPayload{
+		Module:    TokenModule,
+		Function:  "mint_script",
+		Arguments: []interface{}{req.Creator, req.Collection, req.TokenName, req.Amount},
+	})
 }
 
 type OfferTokenRequest struct {
@@ -241,53 +135,18 @@ type OfferTokenRequest struct {
 }
 
 func (impl *TokenClientImpl) OfferToken(ctx context.Context, sender models.SingleSigner, req OfferTokenRequest) (string, error) {
-	tx := models.Transaction{}
-
-	addr := sender.AccountAddress.PrefixZeroTrimmedHex()
-
-	accountInfo, err := impl.client.GetAccount(ctx, addr)
-	if err != nil {
-		return "", fmt.Errorf("get account info error: %w", err)
-	}
-
-	gasPrice, err := impl.client.EstimateGasPrice(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get estimate gas price error: %w", err)
-	}
-
-	err = tx.SetChainID(impl.chainID).
-		SetSender(addr).
-		SetPayload(models.EntryFunctionPayload{
-			Module:   TokenTransferModule,
-			Function: "offer_script",
-			Arguments: []interface{}{
-				req.Receiver,
-				req.Creator,
-				req.Collection,
-				req.TokenName,
-				req.PropertyVersion,
-				req.Amount,
-			},
-		}).
-		SetExpirationTimestampSecs(uint64(time.Now().Add(30 * time.Second).Unix())).
-		SetGasUnitPrice(gasPrice).
-		SetMaxGasAmount(DefaultMaxGasAmount).
-		SetSequenceNumber(accountInfo.SequenceNumber).Error()
-
-	if err != nil {
-		return "", fmt.Errorf("build tx error: %v", err)
-	}
-
-	if err := sender.Sign(&tx).Error(); err != nil {
-		return "", fmt.Errorf("sign tx error: %v", err)
-	}
-
-	txResp, err := impl.client.SubmitTransaction(ctx, tx.UserTransaction)
-	if err != nil {
-		return "", fmt.Errorf("submit tx error: %w", err)
-	}
-
-	return txResp.Hash, nil
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "OfferToken", &sender, models.EntryFunctionPayload{
+		Module:   TokenTransferModule,
+		Function: "offer_script",
+		Arguments: []interface{}{
+			req.Receiver,
+			req.Creator,
+			req.Collection,
+			req.TokenName,
+			req.PropertyVersion,
+			req.Amount,
+		},
+	})
 }
 
 type ClaimTokenRequest struct {
@@ -299,52 +158,17 @@ type ClaimTokenRequest struct {
 }
 
 func (impl *TokenClientImpl) ClaimToken(ctx context.Context, receiver models.SingleSigner, req ClaimTokenRequest) (string, error) {
-	tx := models.Transaction{}
-
-	addr := receiver.AccountAddress.PrefixZeroTrimmedHex()
-
-	accountInfo, err := impl.client.GetAccount(ctx, addr)
-	if err != nil {
-		return "", fmt.Errorf("get account info error: %w", err)
-	}
-
-	gasPrice, err := impl.client.EstimateGasPrice(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get estimate gas price error: %w", err)
-	}
-
-	err = tx.SetChainID(impl.chainID).
-		SetSender(addr).
-		SetPayload(models.EntryFunctionPayload{
-			Module:   TokenTransferModule,
-			Function: "claim_script",
-			Arguments: []interface{}{
-				req.Sender,
-				req.Creator,
-				req.Collection,
-				req.TokenName,
-				req.PropertyVersion,
-			},
-		}).
-		SetExpirationTimestampSecs(uint64(time.Now().Add(30 * time.Second).Unix())).
-		SetGasUnitPrice(gasPrice).
-		SetMaxGasAmount(DefaultMaxGasAmount).
-		SetSequenceNumber(accountInfo.SequenceNumber).Error()
-
-	if err != nil {
-		return "", fmt.Errorf("build tx error: %v", err)
-	}
-
-	if err := receiver.Sign(&tx).Error(); err != nil {
-		return "", fmt.Errorf("sign tx error: %v", err)
-	}
-
-	txResp, err := impl.client.SubmitTransaction(ctx, tx.UserTransaction)
-	if err != nil {
-		return "", fmt.Errorf("submit tx error: %v", err)
-	}
-
-	return txResp.Hash, nil
+	return submitEntryFunction(ctx, impl.client, impl.chainID, "ClaimToken", &receiver, models.EntryFunctionPayload{
+		Module:   TokenTransferModule,
+		Function: "claim_script",
+		Arguments: []interface{}{
+			req.Sender,
+			req.Creator,
+			req.Collection,
+			req.TokenName,
+			req.PropertyVersion,
+		},
+	})
 }
 
 func (impl *TokenClientImpl) GetCollectionData(ctx context.Context, creator models.AccountAddress, collectionName string) (*models.CollectionData, error) {
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// Attribute keys set on the spans of client calls.
+const (
+	AttributeEndpointPath    = "aptos.endpoint.path"
+	AttributeHTTPStatusCode  = "http.status_code"
+	AttributeErrorCode       = "aptos.error_code"
+	AttributeErrorClass      = "aptos.error_class"
+	AttributeLedgerVersion   = "aptos.ledger_version"
+	AttributeTransactionHash = "aptos.transaction.hash"
+	AttributeAttempts        = "aptos.attempts"
+)
+
+// Error classes reported by the AttributeErrorClass attribute.
+const (
+	ErrorClassCanceled    = "canceled"
+	ErrorClassTimeout     = "timeout"
+	ErrorClassTransport   = "transport"
+	ErrorClassRateLimited = "rate_limited"
+	ErrorClassClient      = "client_error"
+	ErrorClassServer      = "server_error"
+	ErrorClassOther       = "other"
+)
+
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer instruments the calls of the clients. Package otelaptos implements it with OpenTelemetry.
+type Tracer interface {
+	// Start starts a span for operation, as a child of the span carried by ctx if any.
+	Start(ctx context.Context, operation string) (context.Context, Span)
+}
+
+// Span is a single instrumented operation.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	// End ends the span. err is the error the operation failed with, or nil.
+	End(err error)
+}
+
+// WithTracer instruments every call of the client with tracer. The TokenClient created from the client
+// uses the same tracer. Calls are not instrumented by default.
+func WithTracer(tracer Tracer) Option {
+	return func(o *clientOptions) {
+		o.tracer = tracer
+	}
+}
+
+// tracerSource is implemented by the clients configured with WithTracer.
+type tracerSource interface {
+	tracer() Tracer
+}
+
+func tracerOf(v interface{}) Tracer {
+	if s, ok := v.(tracerSource); ok {
+		return s.tracer()
+	}
+	return noopTracer{}
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, operation string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attrs ...Attribute) {}
+
+func (noopSpan) End(err error) {}
+
+// errorClass classifies the error of a call whose last response had statusCode.
+func errorClass(statusCode int, err error) string {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorClassRateLimited
+	case statusCode >= http.StatusInternalServerError:
+		return ErrorClassServer
+	case statusCode >= http.StatusBadRequest:
+		return ErrorClassClient
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if urlErr.Timeout() {
+			return ErrorClassTimeout
+		}
+		return ErrorClassTransport
+	}
+	return ErrorClassOther
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultIteratorPageSize     = 100
+	defaultIteratorPollInterval = time.Second
+)
+
+// TransactionIteratorConfig configures a TransactionIterator. Zero values use the defaults.
+type TransactionIteratorConfig struct {
+	// PageSize is the number of transactions fetched per call, 100 by default.
+	PageSize int
+	// PollInterval is the delay between polls once the iterator reached the head of the chain, 1s by default.
+	PollInterval time.Duration
+}
+
+// TransactionIterator yields the transactions of the ledger in version order, starting at a given version. It
+// pages through GetTransactions and polls for new transactions once it reaches the head of the chain.
+//
+// A TransactionIterator is not safe for concurrent use.
+type TransactionIterator struct {
+	client       Transactions
+	opts         []RequestOption
+	pageSize     int
+	pollInterval time.Duration
+
+	version uint64
+	buf     []TransactionResp
+}
+
+// NewTransactionIterator creates a TransactionIterator starting at version start. To resume after a restart,
+// pass the Version of the iterator saved at the last checkpoint.
+func NewTransactionIterator(client Transactions, start uint64, cfg TransactionIteratorConfig,
+	opts ...RequestOption) *TransactionIterator {
+
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = defaultIteratorPageSize
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultIteratorPollInterval
+	}
+
+	return &TransactionIterator{
+		client:       client,
+		opts:         opts,
+		pageSize:     cfg.PageSize,
+		pollInterval: cfg.PollInterval,
+		version:      start,
+	}
+}
+
+// Version returns the version of the next transaction returned by Next. It is the checkpoint to resume from.
+func (it *TransactionIterator) Version() uint64 {
+	return it.version
+}
+
+// Next returns the next transaction, waiting for it to be committed if needed. It returns an error if a call
+// fails or ctx is done, in which case Next can be called again to retry.
+func (it *TransactionIterator) Next(ctx context.Context) (*TransactionResp, error) {
+	for len(it.buf) == 0 {
+		txs, err := it.client.GetTransactions(ctx, int(it.version), it.pageSize, it.opts...)
+		if err != nil && !isAheadOfLedger(err) {
+			return nil, err
+		}
+
+		if len(txs) > 0 {
+			if v, err := strconv.ParseUint(txs[0].Version, 10, 64); err != nil || v != it.version {
+				return nil, fmt.Errorf("unexpected transaction version %q, expected %d", txs[0].Version, it.version)
+			}
+			it.buf = txs
+			break
+		}
+
+		if err := sleepContext(ctx, it.pollInterval); err != nil {
+			return nil, err
+		}
+	}
+
+	tx := it.buf[0]
+	it.buf = it.buf[1:]
+	it.version++
+	return &tx, nil
+}
+
+// Stream runs the iterator in a goroutine and sends the transactions on the returned channel until ctx is
+// done or a call fails. The error channel receives the error that stopped the iterator; both channels are
+// closed when it stops. Version reports the checkpoint once the channels are closed.
+func (it *TransactionIterator) Stream(ctx context.Context) (<-chan TransactionResp, <-chan error) {
+	txCh := make(chan TransactionResp)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(errCh)
+		defer close(txCh)
+
+		for {
+			tx, err := it.Next(ctx)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			select {
+			case txCh <- *tx:
+			case <-ctx.Done():
+				// the transaction was not delivered, so it is read again on resume
+				it.version--
+				it.buf = append([]TransactionResp{*tx}, it.buf...)
+				errCh <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return txCh, errCh
+}
+
+// isAheadOfLedger reports whether err was returned for a start version above the current ledger version.
+func isAheadOfLedger(err error) bool {
+	var e *Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.HasErrorCode(ErrVersionNotFound) || e.HasErrorCode(ErrTransactionNotFound)
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+const (
+	TransactionTypeUser            = "user_transaction"
+	TransactionTypeBlockMetadata   = "block_metadata_transaction"
+	TransactionTypeStateCheckpoint = "state_checkpoint_transaction"
+	TransactionTypeGenesis         = "genesis_transaction"
+	TransactionTypePending         = "pending_transaction"
+	TransactionTypeValidator       = "validator_transaction"
+)
+
+// TransactionVariant is one of the typed transactions returned by DecodeTransaction and the GetTyped methods:
+// *UserTransactionResp, *BlockMetadataTransactionResp, *StateCheckpointTransactionResp, *GenesisTransactionResp,
+// *PendingTransactionResp or *ValidatorTransactionResp.
+type TransactionVariant interface {
+	TransactionType() string
+}
+
+// TransactionInfo holds the fields shared by the committed transactions.
+type TransactionInfo struct {
+	Version             models.Uint64          `json:"version"`
+	Hash                string                 `json:"hash"`
+	StateChangeHash     string                 `json:"state_change_hash"`
+	EventRootHash       string                 `json:"event_root_hash"`
+	StateCheckpointHash *string                `json:"state_checkpoint_hash"`
+	GasUsed             models.Uint64          `json:"gas_used"`
+	Success             bool                   `json:"success"`
+	VmStatus            string                 `json:"vm_status"`
+	AccumulatorRootHash string                 `json:"accumulator_root_hash"`
+	Changes             models.WriteSetChanges `json:"changes"`
+}
+
+// UserRequest holds the fields of a transaction submitted by a user.
+type UserRequest struct {
+	Sender                  string                `json:"sender"`
+	SequenceNumber          models.Uint64         `json:"sequence_number"`
+	MaxGasAmount            models.Uint64         `json:"max_gas_amount"`
+	GasUnitPrice            models.Uint64         `json:"gas_unit_price"`
+	ExpirationTimestampSecs models.Uint64         `json:"expiration_timestamp_secs"`
+	Payload                 models.JSONPayload    `json:"payload"`
+	Signature               *models.JSONSignature `json:"signature,omitempty"`
+}
+
+type UserTransactionResp struct {
+	TransactionInfo
+	UserRequest
+
+	Events    []models.Event `json:"events"`
+	Timestamp models.Uint64  `json:"timestamp"`
+}
+
+type BlockMetadataTransactionResp struct {
+	TransactionInfo
+
+	ID                       string         `json:"id"`
+	Epoch                    models.Uint64  `json:"epoch"`
+	Round                    models.Uint64  `json:"round"`
+	Events                   []models.Event `json:"events"`
+	PreviousBlockVotesBitvec []uint8        `json:"previous_block_votes_bitvec"`
+	Proposer                 string         `json:"proposer"`
+	FailedProposerIndices    []uint32       `json:"failed_proposer_indices"`
+	Timestamp                models.Uint64  `json:"timestamp"`
+}
+
+type StateCheckpointTransactionResp struct {
+	TransactionInfo
+
+	Timestamp models.Uint64 `json:"timestamp"`
+}
+
+type GenesisTransactionResp struct {
+	TransactionInfo
+
+	// Payload is the write set of the genesis, kept undecoded.
+	Payload json.RawMessage `json:"payload"`
+	Events  []models.Event  `json:"events"`
+}
+
+type PendingTransactionResp struct {
+	UserRequest
+
+	Hash string `json:"hash"`
+}
+
+type ValidatorTransactionResp struct {
+	TransactionInfo
+
+	ValidatorTransactionType string         `json:"validator_transaction_type"`
+	Events                   []models.Event `json:"events"`
+	Timestamp                models.Uint64  `json:"timestamp"`
+}
+
+func (*UserTransactionResp) TransactionType() string {
+	return TransactionTypeUser
+}
+
+func (*BlockMetadataTransactionResp) TransactionType() string {
+	return TransactionTypeBlockMetadata
+}
+
+func (*StateCheckpointTransactionResp) TransactionType() string {
+	return TransactionTypeStateCheckpoint
+}
+
+func (*GenesisTransactionResp) TransactionType() string {
+	return TransactionTypeGenesis
+}
+
+func (*PendingTransactionResp) TransactionType() string {
+	return TransactionTypePending
+}
+
+func (*ValidatorTransactionResp) TransactionType() string {
+	return TransactionTypeValidator
+}
+
+// DecodeTransaction decodes the JSON of a transaction into its typed variant according to its type field.
+func DecodeTransaction(data []byte) (TransactionVariant, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	var tx TransactionVariant
+	switch header.Type {
+	case TransactionTypeUser:
+		tx = &UserTransactionResp{}
+	case TransactionTypeBlockMetadata:
+		tx = &BlockMetadataTransactionResp{}
+	case TransactionTypeStateCheckpoint:
+		tx = &StateCheckpointTransactionResp{}
+	case TransactionTypeGenesis:
+		tx = &GenesisTransactionResp{}
+	case TransactionTypePending:
+		tx = &PendingTransactionResp{}
+	case TransactionTypeValidator:
+		tx = &ValidatorTransactionResp{}
+	default:
+		return nil, fmt.Errorf("unknown transaction type %q", header.Type)
+	}
+
+	if err := json.Unmarshal(data, tx); err != nil {
+		return nil, fmt.Errorf("decode %s error: %w", header.Type, err)
+	}
+	return tx, nil
+}
+
+// TransactionVariants is a list of transactions decoded into their typed variants.
+type TransactionVariants []TransactionVariant
+
+func (v *TransactionVariants) UnmarshalJSON(b []byte) error {
+	var txs []json.RawMessage
+	if err := json.Unmarshal(b, &txs); err != nil {
+		return err
+	}
+
+	variants := make(TransactionVariants, len(txs))
+	for i, tx := range txs {
+		var err error
+		if variants[i], err = DecodeTransaction(tx); err != nil {
+			return fmt.Errorf("transactions[%d]: %w", i, err)
+		}
+	}
+	*v = variants
+	return nil
+}
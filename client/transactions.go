@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -10,14 +12,19 @@ import (
 )
 
 type Transactions interface {
-	GetTransactions(ctx context.Context, start, limit int, opts ...interface{}) ([]TransactionResp, error)
-	SubmitTransaction(ctx context.Context, tx models.UserTransaction, opts ...interface{}) (*TransactionResp, error)
-	SimulateTransaction(ctx context.Context, tx models.UserTransaction, estimateGasUnitPrice, estimateMaxGasAmount bool, opts ...interface{}) ([]TransactionResp, error)
-	GetAccountTransactions(ctx context.Context, address string, start, limit int, opts ...interface{}) ([]TransactionResp, error)
-	GetTransactionByHash(ctx context.Context, txHash string, opts ...interface{}) (*TransactionResp, error)
-	GetTransactionByVersion(ctx context.Context, version uint64, opts ...interface{}) (*TransactionResp, error)
-	EstimateGasPrice(ctx context.Context, opts ...interface{}) (uint64, error)
+	GetTransactions(ctx context.Context, start, limit int, opts ...RequestOption) ([]TransactionResp, error)
+	SubmitTransaction(ctx context.Context, tx models.UserTransaction, opts ...RequestOption) (*TransactionResp, error)
+	SimulateTransaction(ctx context.Context, tx models.UserTransaction, estimateGasUnitPrice, estimateMaxGasAmount bool, opts ...RequestOption) ([]TransactionResp, error)
+	GetAccountTransactions(ctx context.Context, address string, start, limit int, opts ...RequestOption) ([]TransactionResp, error)
+	GetTransactionByHash(ctx context.Context, txHash string, opts ...RequestOption) (*TransactionResp, error)
+	GetTransactionByVersion(ctx context.Context, version uint64, opts ...RequestOption) (*TransactionResp, error)
+	GetTypedTransactions(ctx context.Context, start, limit int, opts ...RequestOption) ([]TransactionVariant, error)
+	GetTypedAccountTransactions(ctx context.Context, address string, start, limit int, opts ...RequestOption) ([]TransactionVariant, error)
+	GetTypedTransactionByHash(ctx context.Context, txHash string, opts ...RequestOption) (TransactionVariant, error)
+	GetTypedTransactionByVersion(ctx context.Context, version uint64, opts ...RequestOption) (TransactionVariant, error)
+	EstimateGasPrice(ctx context.Context, opts ...RequestOption) (uint64, error)
 	WaitForTransaction(ctx context.Context, txHash string) error
+	WaitForTransactionWithOptions(ctx context.Context, txHash string, waitOpts WaitOptions, opts ...RequestOption) (*TransactionResp, error)
 }
 
 type TransactionsImpl struct {
@@ -57,9 +64,9 @@ type TransactionResp struct {
 	Changes             []models.Change `json:"changes"`
 }
 
-func (impl TransactionsImpl) GetTransactions(ctx context.Context, start, limit int, opts ...interface{}) ([]TransactionResp, error) {
+func (impl TransactionsImpl) GetTransactions(ctx context.Context, start, limit int, opts ...RequestOption) ([]TransactionResp, error) {
 	var rspJSON []TransactionResp
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetTransactions", http.MethodGet,
 		impl.Base.Endpoint()+"/v1/transactions",
 		nil, &rspJSON, map[string]interface{}{
 			"start": start,
@@ -72,9 +79,9 @@ func (impl TransactionsImpl) GetTransactions(ctx context.Context, start, limit i
 	return rspJSON, nil
 }
 
-func (impl TransactionsImpl) SubmitTransaction(ctx context.Context, tx models.UserTransaction, opts ...interface{}) (*TransactionResp, error) {
+func (impl TransactionsImpl) SubmitTransaction(ctx context.Context, tx models.UserTransaction, opts ...RequestOption) (*TransactionResp, error) {
 	var rspJSON TransactionResp
-	err := request(ctx, http.MethodPost,
+	err := impl.Base.request(ctx, "SubmitTransaction", http.MethodPost,
 		impl.Base.Endpoint()+"/v1/transactions",
 		tx, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
@@ -85,9 +92,9 @@ func (impl TransactionsImpl) SubmitTransaction(ctx context.Context, tx models.Us
 }
 
 func (impl TransactionsImpl) SimulateTransaction(ctx context.Context, tx models.UserTransaction,
-	estimateGasUnitPrice, estimateMaxGasAmount bool, opts ...interface{}) ([]TransactionResp, error) {
+	estimateGasUnitPrice, estimateMaxGasAmount bool, opts ...RequestOption) ([]TransactionResp, error) {
 	var rspJSON []TransactionResp
-	err := request(ctx, http.MethodPost,
+	err := impl.Base.request(ctx, "SimulateTransaction", http.MethodPost,
 		impl.Base.Endpoint()+"/v1/transactions/simulate",
 		tx.ForSimulate(), &rspJSON, map[string]interface{}{
 			"estimate_gas_unit_price": estimateGasUnitPrice,
@@ -100,9 +107,9 @@ func (impl TransactionsImpl) SimulateTransaction(ctx context.Context, tx models.
 	return rspJSON, nil
 }
 
-func (impl TransactionsImpl) GetAccountTransactions(ctx context.Context, address string, start, limit int, opts ...interface{}) ([]TransactionResp, error) {
+func (impl TransactionsImpl) GetAccountTransactions(ctx context.Context, address string, start, limit int, opts ...RequestOption) ([]TransactionResp, error) {
 	var rspJSON []TransactionResp
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetAccountTransactions", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/transactions", address),
 		nil, &rspJSON, map[string]interface{}{
 			"start": start,
@@ -115,9 +122,9 @@ func (impl TransactionsImpl) GetAccountTransactions(ctx context.Context, address
 	return rspJSON, nil
 }
 
-func (impl TransactionsImpl) GetTransactionByHash(ctx context.Context, txHash string, opts ...interface{}) (*TransactionResp, error) {
+func (impl TransactionsImpl) GetTransactionByHash(ctx context.Context, txHash string, opts ...RequestOption) (*TransactionResp, error) {
 	var rspJSON TransactionResp
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetTransactionByHash", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/transactions/by_hash/%s", txHash),
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
@@ -127,9 +134,9 @@ func (impl TransactionsImpl) GetTransactionByHash(ctx context.Context, txHash st
 	return &rspJSON, nil
 }
 
-func (impl TransactionsImpl) GetTransactionByVersion(ctx context.Context, version uint64, opts ...interface{}) (*TransactionResp, error) {
+func (impl TransactionsImpl) GetTransactionByVersion(ctx context.Context, version uint64, opts ...RequestOption) (*TransactionResp, error) {
 	var rspJSON TransactionResp
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "GetTransactionByVersion", http.MethodGet,
 		impl.Base.Endpoint()+fmt.Sprintf("/v1/transactions/by_version/%d", version),
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
@@ -139,12 +146,66 @@ func (impl TransactionsImpl) GetTransactionByVersion(ctx context.Context, versio
 	return &rspJSON, nil
 }
 
-func (impl TransactionsImpl) EstimateGasPrice(ctx context.Context, opts ...interface{}) (uint64, error) {
+func (impl TransactionsImpl) GetTypedTransactions(ctx context.Context, start, limit int, opts ...RequestOption) ([]TransactionVariant, error) {
+	var rspJSON TransactionVariants
+	err := impl.Base.request(ctx, "GetTypedTransactions", http.MethodGet,
+		impl.Base.Endpoint()+"/v1/transactions",
+		nil, &rspJSON, map[string]interface{}{
+			"start": start,
+			"limit": limit,
+		}, requestOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return rspJSON, nil
+}
+
+func (impl TransactionsImpl) GetTypedAccountTransactions(ctx context.Context, address string, start, limit int, opts ...RequestOption) ([]TransactionVariant, error) {
+	var rspJSON TransactionVariants
+	err := impl.Base.request(ctx, "GetTypedAccountTransactions", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/accounts/%s/transactions", address),
+		nil, &rspJSON, map[string]interface{}{
+			"start": start,
+			"limit": limit,
+		}, requestOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return rspJSON, nil
+}
+
+func (impl TransactionsImpl) GetTypedTransactionByHash(ctx context.Context, txHash string, opts ...RequestOption) (TransactionVariant, error) {
+	var rspJSON json.RawMessage
+	err := impl.Base.request(ctx, "GetTypedTransactionByHash", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/transactions/by_hash/%s", txHash),
+		nil, &rspJSON, nil, requestOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeTransaction(rspJSON)
+}
+
+func (impl TransactionsImpl) GetTypedTransactionByVersion(ctx context.Context, version uint64, opts ...RequestOption) (TransactionVariant, error) {
+	var rspJSON json.RawMessage
+	err := impl.Base.request(ctx, "GetTypedTransactionByVersion", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/transactions/by_version/%d", version),
+		nil, &rspJSON, nil, requestOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeTransaction(rspJSON)
+}
+
+func (impl TransactionsImpl) EstimateGasPrice(ctx context.Context, opts ...RequestOption) (uint64, error) {
 	type response struct {
 		GasEstimate uint64 `json:"gas_estimate"`
 	}
 	var rspJSON response
-	err := request(ctx, http.MethodGet,
+	err := impl.Base.request(ctx, "EstimateGasPrice", http.MethodGet,
 		impl.Base.Endpoint()+"/v1/estimate_gas_price",
 		nil, &rspJSON, nil, requestOptions(opts...))
 	if err != nil {
@@ -159,14 +220,20 @@ const (
 	maxRetryCount = 10
 )
 
-func (impl TransactionsImpl) WaitForTransaction(ctx context.Context, txHash string) error {
+func (impl TransactionsImpl) WaitForTransaction(ctx context.Context, txHash string) (err error) {
+	ctx, span := impl.Base.tracer().Start(ctx, "WaitForTransaction")
+	span.SetAttributes(Attribute{Key: AttributeTransactionHash, Value: txHash})
+	defer func() { span.End(err) }()
+
 	var isPending bool = true
 	var count int
 	for isPending && count < maxRetryCount {
 		tx, err := impl.GetTransactionByHash(ctx, txHash)
 		isPending = (err != nil || tx.Type == "pending_transaction")
 		if isPending {
-			time.Sleep(1 * time.Second)
+			if err := sleepContext(ctx, 1*time.Second); err != nil {
+				return err
+			}
 			count += 1
 		}
 	}
@@ -176,3 +243,93 @@ func (impl TransactionsImpl) WaitForTransaction(ctx context.Context, txHash stri
 	}
 	return nil
 }
+
+// WaitOptions configures WaitForTransactionWithOptions. Zero values use the defaults.
+type WaitOptions struct {
+	// Timeout bounds the whole wait, 30s by default.
+	Timeout time.Duration
+	// Interval is the delay between two polls, 1s by default.
+	Interval time.Duration
+	// Multiplier grows Interval after each poll up to MaxInterval. Values up to 1 keep it constant.
+	Multiplier float64
+	// MaxInterval caps the delay between two polls, 10s by default.
+	MaxInterval time.Duration
+	// LongPoll uses the wait_by_hash endpoint, on which the node holds the call until the transaction is
+	// committed or its own wait time is elapsed.
+	LongPoll bool
+}
+
+// WaitForTransactionWithOptions polls the transaction txHash until it is committed and returns it. If the
+// transaction was committed but not executed successfully, it returns the transaction along with a
+// *TransactionFailedError. While the transaction is not found yet or is pending, and on transient errors,
+// it keeps polling until the timeout, after which it fails with a *TransactionNotCommittedError.
+func (impl TransactionsImpl) WaitForTransactionWithOptions(ctx context.Context, txHash string, waitOpts WaitOptions,
+	opts ...RequestOption) (tx *TransactionResp, err error) {
+
+	ctx, span := impl.Base.tracer().Start(ctx, "WaitForTransactionWithOptions")
+	span.SetAttributes(Attribute{Key: AttributeTransactionHash, Value: txHash})
+	defer func() { span.End(err) }()
+
+	if waitOpts.Timeout <= 0 {
+		waitOpts.Timeout = 30 * time.Second
+	}
+	if waitOpts.Interval <= 0 {
+		waitOpts.Interval = time.Second
+	}
+	if waitOpts.MaxInterval <= 0 {
+		waitOpts.MaxInterval = 10 * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, waitOpts.Timeout)
+	defer cancel()
+
+	interval := waitOpts.Interval
+	var pending bool
+	for {
+		var err error
+		if waitOpts.LongPoll {
+			tx, err = impl.waitTransactionByHash(ctx, txHash, opts...)
+		} else {
+			tx, err = impl.GetTransactionByHash(ctx, txHash, opts...)
+		}
+
+		switch {
+		case err == nil && tx.Type != "pending_transaction":
+			if !tx.Success {
+				return tx, &TransactionFailedError{Hash: txHash, VmStatus: tx.VmStatus, Transaction: tx}
+			}
+			return tx, nil
+		case err == nil:
+			pending = true
+			if waitOpts.LongPoll {
+				// the node already held the call
+				continue
+			}
+		case ctx.Err() != nil:
+		case !errors.Is(err, ErrTransactionNotFound) && !IsRetryable(err):
+			return nil, err
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, &TransactionNotCommittedError{Hash: txHash, Pending: pending, Err: err}
+		}
+		if waitOpts.Multiplier > 1 {
+			interval = time.Duration(float64(interval) * waitOpts.Multiplier)
+			if interval > waitOpts.MaxInterval {
+				interval = waitOpts.MaxInterval
+			}
+		}
+	}
+}
+
+func (impl TransactionsImpl) waitTransactionByHash(ctx context.Context, txHash string, opts ...RequestOption) (*TransactionResp, error) {
+	var rspJSON TransactionResp
+	err := impl.Base.request(ctx, "WaitTransactionByHash", http.MethodGet,
+		impl.Base.Endpoint()+fmt.Sprintf("/v1/transactions/wait_by_hash/%s", txHash),
+		nil, &rspJSON, nil, requestOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &rspJSON, nil
+}
@@ -1,11 +1,21 @@
 package client
 
 import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/the729/lcs"
+
+	"github.com/portto/aptos-go-sdk/models"
 )
 
 func TestGetTransactionByHash(t *testing.T) {
@@ -44,3 +54,286 @@ func TestWaitForTransaction(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestTransactionIterator(t *testing.T) {
+	var mu sync.Mutex
+	ledgerVersion := 4
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+		if start > ledgerVersion {
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"message":"not found","error_code":"version_not_found"}`))
+			assert.NoError(t, err)
+			// a new transaction is committed while the iterator waits
+			ledgerVersion++
+			return
+		}
+
+		var txs []TransactionResp
+		for v := start; v <= ledgerVersion && len(txs) < limit; v++ {
+			txs = append(txs, TransactionResp{Version: strconv.Itoa(v)})
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(txs))
+	}))
+	defer srv.Close()
+
+	it := NewTransactionIterator(NewAptosClient(srv.URL), 2, TransactionIteratorConfig{
+		PageSize:     2,
+		PollInterval: time.Millisecond,
+	})
+	for v := 2; v <= 6; v++ {
+		tx, err := it.Next(mockCTX)
+		assert.NoError(t, err)
+		assert.Equal(t, strconv.Itoa(v), tx.Version)
+	}
+	assert.Equal(t, uint64(7), it.Version())
+
+	ctx, cancel := context.WithCancel(mockCTX)
+	txs, errs := NewTransactionIterator(NewAptosClient(srv.URL), it.Version(), TransactionIteratorConfig{
+		PollInterval: time.Millisecond,
+	}).Stream(ctx)
+	tx := <-txs
+	assert.Equal(t, "7", tx.Version)
+	cancel()
+	for range txs {
+	}
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	// a 404 without an Aptos error code, e.g. from a wrong base path, is not waited on
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	_, err := NewTransactionIterator(NewAptosClient(notFound.URL), 0, TransactionIteratorConfig{}).Next(mockCTX)
+	var e *Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, http.StatusNotFound, e.StatusCode)
+}
+
+func TestBackfill(t *testing.T) {
+	var mu sync.Mutex
+	failed := make(map[int]bool)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+
+		// every chunk fails once, and the node pages at most 3 transactions
+		mu.Lock()
+		fail := !failed[start]
+		failed[start] = true
+		mu.Unlock()
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, err := w.Write([]byte(`{"message":"unavailable","error_code":"internal_error"}`))
+			assert.NoError(t, err)
+			return
+		}
+
+		var txs []TransactionResp
+		for v := start; v < start+limit && len(txs) < 3; v++ {
+			txs = append(txs, TransactionResp{Version: strconv.Itoa(v)})
+		}
+		time.Sleep(time.Duration(50-start) * time.Millisecond / 10)
+		assert.NoError(t, json.NewEncoder(w).Encode(txs))
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+	policy := RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+	var versions []string
+	var progress []BackfillProgress
+	err := Backfill(mockCTX, c, 10, 30, BackfillConfig{
+		ChunkSize:   5,
+		Concurrency: 3,
+		RetryPolicy: &policy,
+		Progress: func(p BackfillProgress) {
+			progress = append(progress, p)
+		},
+	}, func(tx TransactionResp) error {
+		versions = append(versions, tx.Version)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 20, len(versions))
+	for i, v := range versions {
+		assert.Equal(t, strconv.Itoa(10+i), v)
+	}
+	assert.Equal(t, 4, len(progress))
+	assert.Equal(t, BackfillProgress{Start: 10, End: 30, Version: 30, Delivered: 20}, progress[3])
+
+	ctx, cancel := context.WithCancel(mockCTX)
+	err = Backfill(ctx, c, 0, 1000, BackfillConfig{ChunkSize: 5, RetryPolicy: &policy}, func(tx TransactionResp) error {
+		cancel()
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWaitForTransactionWithOptions(t *testing.T) {
+	var mu sync.Mutex
+	var polls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var body string
+		switch req.URL.Path {
+		case "/v1/transactions/by_hash/0x1", "/v1/transactions/wait_by_hash/0x1":
+			// not found, then pending, then failed
+			switch polls++; polls {
+			case 1:
+				w.WriteHeader(http.StatusNotFound)
+				body = `{"message":"not found","error_code":"transaction_not_found"}`
+			case 2:
+				body = `{"type":"pending_transaction","hash":"0x1"}`
+			default:
+				body = `{"type":"user_transaction","hash":"0x1","success":false,"vm_status":"Move abort in 0x1::coin: EINSUFFICIENT_BALANCE(0x10006)"}`
+			}
+		case "/v1/transactions/by_hash/0x2":
+			w.WriteHeader(http.StatusBadRequest)
+			body = `{"message":"invalid hash","error_code":"invalid_input"}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			body = `{"message":"not found","error_code":"transaction_not_found"}`
+		}
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+	waitOpts := WaitOptions{Interval: time.Millisecond, Timeout: 100 * time.Millisecond}
+
+	for _, longPoll := range []bool{false, true} {
+		polls = 0
+		waitOpts.LongPoll = longPoll
+		tx, err := c.WaitForTransactionWithOptions(mockCTX, "0x1", waitOpts)
+		var failed *TransactionFailedError
+		if assert.ErrorAs(t, err, &failed) {
+			assert.Equal(t, "Move abort in 0x1::coin: EINSUFFICIENT_BALANCE(0x10006)", failed.VmStatus)
+		}
+		assert.Equal(t, "0x1", tx.Hash)
+		assert.Equal(t, 3, polls)
+	}
+
+	waitOpts.LongPoll = false
+	_, err := c.WaitForTransactionWithOptions(mockCTX, "0x2", waitOpts)
+	var e *Error
+	assert.ErrorAs(t, err, &e)
+
+	_, err = c.WaitForTransactionWithOptions(mockCTX, "0x3", waitOpts)
+	var notCommitted *TransactionNotCommittedError
+	if assert.ErrorAs(t, err, &notCommitted) {
+		assert.False(t, notCommitted.Pending)
+	}
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAbortResolver(t *testing.T) {
+	value, err := lcs.Marshal([]struct {
+		Reason            uint64
+		Name, Description string
+	}{{Reason: 6, Name: "EINSUFFICIENT_BALANCE", Description: "Not enough coins to complete transaction"}})
+	assert.NoError(t, err)
+	key := "aptos::metadata_v1"
+	table := append(append(append([]byte{byte(len(key))}, key...), byte(len(value)+2)), value...)
+	table = append(table, 0, 0)
+	bytecode := append([]byte{0xa1, 0x1c, 0xeb, 0x0b, 6, 0, 0, 0, 1, 0x10, 0, byte(len(table))}, table...)
+
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		assert.Equal(t, "/v1/accounts/0x1/module/coin", req.URL.Path)
+		assert.NoError(t, json.NewEncoder(w).Encode(AccountModule{Bytecode: "0x" + hex.EncodeToString(bytecode)}))
+	}))
+	defer srv.Close()
+
+	r := NewAbortResolver(NewAptosClient(srv.URL))
+	err = &TransactionFailedError{Hash: "0x1", VmStatus: "Move abort in 0x1::coin: 0x10006"}
+	var failed *TransactionFailedError
+	assert.ErrorAs(t, err, &failed)
+	for i := 0; i < 2; i++ {
+		status, err := r.Resolve(mockCTX, failed.Status())
+		assert.NoError(t, err)
+		assert.Equal(t, "EINSUFFICIENT_BALANCE", status.ReasonName)
+		assert.Equal(t, "Not enough coins to complete transaction", status.Description)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestTransactionVariants(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var err error
+		switch req.URL.Path {
+		case "/v1/transactions":
+			_, err = w.Write([]byte(`[
+				{"type":"block_metadata_transaction","version":"10","hash":"0xa","gas_used":"0","success":true,"vm_status":"Executed successfully","id":"0xb","epoch":"2","round":"5","events":[],"previous_block_votes_bitvec":[255],"proposer":"0x1","failed_proposer_indices":[],"timestamp":"1000"},
+				{"type":"user_transaction","version":"11","hash":"0xc","gas_used":"7","success":false,"vm_status":"Out of gas","sender":"0x2","sequence_number":"3","max_gas_amount":"100","gas_unit_price":"100","expiration_timestamp_secs":"2000","payload":{"type":"entry_function_payload","function":"0x1::coin::transfer","type_arguments":[],"arguments":[]},"events":[],"timestamp":"1000"},
+				{"type":"state_checkpoint_transaction","version":"12","hash":"0xd","gas_used":"0","success":true,"vm_status":"Executed successfully","timestamp":"1000"}
+			]`))
+		case "/v1/accounts/" + mockAddr + "/transactions":
+			assert.Equal(t, "0", req.URL.Query().Get("start"))
+			_, err = w.Write([]byte(`[
+				{"type":"user_transaction","version":"11","hash":"0xc","gas_used":"7","success":true,"vm_status":"Executed successfully","sender":"` + mockAddr + `","sequence_number":"0","max_gas_amount":"100","gas_unit_price":"100","expiration_timestamp_secs":"2000","payload":{"type":"entry_function_payload","function":"0x1::coin::transfer","type_arguments":[],"arguments":[]},"events":[],"timestamp":"1000"}
+			]`))
+		case "/v1/transactions/by_version/12":
+			_, err = w.Write([]byte(`{"type":"state_checkpoint_transaction","version":"12","hash":"0xd","gas_used":"0","success":true,"vm_status":"Executed successfully","timestamp":"1000","changes":[{"type":"delete_resource","address":"0xa","state_key_hash":"0x3","resource":"0x1::account::Account"}]}`))
+		case "/v1/blocks/by_height/3":
+			assert.Equal(t, "true", req.URL.Query().Get("with_transactions"))
+			_, err = w.Write([]byte(`{"block_height":"3","block_hash":"0xe","block_timestamp":"1000","first_version":"12","last_version":"12","transactions":[
+				{"type":"state_checkpoint_transaction","version":"12","hash":"0xd","gas_used":"0","success":true,"vm_status":"Executed successfully","timestamp":"1000"}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+	txs, err := c.GetTypedTransactions(mockCTX, 10, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(txs))
+
+	if meta, ok := txs[0].(*BlockMetadataTransactionResp); assert.True(t, ok) {
+		assert.Equal(t, models.Uint64(10), meta.Version)
+		assert.Equal(t, models.Uint64(5), meta.Round)
+		assert.Equal(t, []uint8{255}, meta.PreviousBlockVotesBitvec)
+	}
+	if user, ok := txs[1].(*UserTransactionResp); assert.True(t, ok) {
+		assert.Equal(t, models.Uint64(3), user.SequenceNumber)
+		assert.Equal(t, models.Uint64(7), user.GasUsed)
+		assert.Equal(t, "0x1::coin::transfer", user.Payload.Function)
+		assert.False(t, user.Success)
+	}
+	assert.Equal(t, TransactionTypeStateCheckpoint, txs[2].TransactionType())
+
+	txs, err = c.GetTypedAccountTransactions(mockCTX, mockAddr, 0, 1)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(txs)) {
+		if user, ok := txs[0].(*UserTransactionResp); assert.True(t, ok) {
+			assert.Equal(t, mockAddr, user.Sender)
+			assert.Equal(t, models.Uint64(0), user.SequenceNumber)
+			assert.True(t, user.Success)
+		}
+	}
+
+	tx, err := c.GetTypedTransactionByVersion(mockCTX, 12)
+	assert.NoError(t, err)
+	if checkpoint, ok := tx.(*StateCheckpointTransactionResp); assert.True(t, ok) && assert.Equal(t, 1, len(checkpoint.Changes)) {
+		assert.Equal(t, models.ChangeTypeDeleteResource, checkpoint.Changes[0].ChangeType())
+	}
+
+	block, err := c.GetTypedBlockByHeight(mockCTX, 3, true)
+	assert.NoError(t, err)
+	assert.Equal(t, models.Uint64(3), block.BlockHeight)
+	if assert.Equal(t, 1, len(block.Transactions)) {
+		assert.Equal(t, TransactionTypeStateCheckpoint, block.Transactions[0].TransactionType())
+	}
+
+	_, err = DecodeTransaction([]byte(`{"type":"unknown"}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+type Views interface {
+	View(ctx context.Context, module models.Module, function string, typeArgs []models.TypeTag, args []interface{},
+		resp interface{}, opts ...RequestOption) error
+}
+
+type ViewsImpl struct {
+	Base
+}
+
+type ViewRequest struct {
+	Function      string        `json:"function"`
+	TypeArguments []string      `json:"type_arguments"`
+	Arguments     []interface{} `json:"arguments"`
+}
+
+// View calls the #[view] function of module and decodes its JSON results into resp, usually a pointer to a
+// slice or to a struct of the return values. The arguments take the same Go types as the arguments of an
+// models.EntryFunctionPayload. The request is sent as JSON, or as BCS with WithBCSRequest. WithLedgerVersion
+// runs the function at a past version.
+func (impl ViewsImpl) View(ctx context.Context, module models.Module, function string, typeArgs []models.TypeTag,
+	args []interface{}, resp interface{}, opts ...RequestOption) error {
+
+	viewFunc := models.ViewFunction{
+		Module:        module,
+		Function:      function,
+		TypeArguments: typeArgs,
+		Arguments:     args,
+	}
+
+	cfg := requestOptions(opts...)
+	var reqBody interface{}
+	if cfg.bcsRequest {
+		var err error
+		if reqBody, err = viewFunc.EncodeArguments(); err != nil {
+			return err
+		}
+	} else {
+		typeArgStrings := make([]string, len(typeArgs))
+		for i, typeArg := range typeArgs {
+			typeArgStrings[i] = models.MoveTypeString(typeArg)
+		}
+		reqBody = ViewRequest{
+			Function:      module.Address.PrefixZeroTrimmedHex() + "::" + module.Name + "::" + function,
+			TypeArguments: typeArgStrings,
+			Arguments:     viewFunc.JSONArguments(),
+		}
+	}
+
+	return impl.Base.request(ctx, "View", http.MethodPost,
+		impl.Base.Endpoint()+"/v1/view",
+		reqBody, resp, nil, cfg)
+}
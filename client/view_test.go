@@ -0,0 +1,62 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/the729/lcs"
+
+	"github.com/portto/aptos-go-sdk/models"
+)
+
+func TestView(t *testing.T) {
+	addr, err := models.HexToAccountAddress(mockAddr)
+	assert.NoError(t, err)
+	coin := models.TypeTagStruct{Address: models.AccountAddress{31: 1}, Module: "aptos_coin", Name: "AptosCoin"}
+	module := models.Module{Address: models.AccountAddress{31: 1}, Name: "coin"}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/view", req.URL.Path)
+		assert.Equal(t, "42", req.URL.Query().Get("ledger_version"))
+
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		switch req.Header.Get("Content-Type") {
+		case "application/json":
+			assert.JSONEq(t, `{
+				"function": "0x1::coin::balance",
+				"type_arguments": ["0x1::aptos_coin::AptosCoin"],
+				"arguments": ["`+mockAddr+`"]
+			}`, string(body))
+		case "application/x.aptos.view_function+bcs":
+			expected, err := lcs.Marshal(models.ViewFunction{
+				Module:        module,
+				Function:      "balance",
+				TypeArguments: []models.TypeTag{coin},
+				ArgumentsBCS:  [][]byte{addr[:]},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, expected, body)
+		default:
+			t.Errorf("unexpected content type %s", req.Header.Get("Content-Type"))
+		}
+
+		assert.NoError(t, json.NewEncoder(w).Encode([]string{"100"}))
+	}))
+	defer srv.Close()
+
+	c := NewAptosClient(srv.URL)
+	for _, opts := range [][]RequestOption{
+		{WithLedgerVersion(42)},
+		{WithLedgerVersion(42), WithBCSRequest()},
+	} {
+		var balance []string
+		err := c.View(ctx, module, "balance", []models.TypeTag{coin}, []interface{}{addr}, &balance, opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"100"}, balance)
+	}
+}
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"encoding/binary"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Domain separators appended by the framework when deriving an address, so that addresses derived with
+// different schemes never collide.
+const (
+	UserDerivedObjectAddressScheme byte = 0xFC
+	GUIDObjectAddressScheme        byte = 0xFD
+	SeedObjectAddressScheme        byte = 0xFE
+)
+
+// ObjectAddress returns the address of the named object created by source with seed, as computed by
+// 0x1::object::create_object_address.
+func ObjectAddress(source, seed []byte) [32]byte {
+	return deriveAddress(SeedObjectAddressScheme, source, seed)
+}
+
+// UserDerivedObjectAddress returns the address of the object derived from the address deriveFrom by the
+// account source, such as the primary fungible store of an account, as computed by
+// 0x1::object::create_user_derived_object_address.
+func UserDerivedObjectAddress(source, deriveFrom []byte) [32]byte {
+	return deriveAddress(UserDerivedObjectAddressScheme, source, deriveFrom)
+}
+
+// GUIDObjectAddress returns the address of the object created by source from the GUID of creation number
+// creationNum, such as the objects created with 0x1::object::create_object_from_account.
+func GUIDObjectAddress(source []byte, creationNum uint64) [32]byte {
+	// the BCS encoding of a 0x1::guid::GUID
+	var num [8]byte
+	binary.LittleEndian.PutUint64(num[:], creationNum)
+	return deriveAddress(GUIDObjectAddressScheme, num[:], source)
+}
+
+func deriveAddress(scheme byte, parts ...[]byte) [32]byte {
+	h := sha3.New256()
+	for _, part := range parts {
+		h.Write(part)
+	}
+	h.Write([]byte{scheme})
+
+	var addr [32]byte
+	copy(addr[:], h.Sum(nil))
+	return addr
+}
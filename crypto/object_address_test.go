@@ -0,0 +1,25 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectAddress(t *testing.T) {
+	source := make([]byte, 32)
+	source[30], source[31] = 0x0b, 0x0b
+
+	addr := ObjectAddress(source, []byte("bob's collection"))
+	assert.Equal(t, "f417184602a828a3819edf5e36285ebef5e4db1ba36270be580d6fd2d7bcc321", hex.EncodeToString(addr[:]))
+
+	// the primary store of 0x1 for the metadata 0xa
+	owner, metadata := make([]byte, 32), make([]byte, 32)
+	owner[31], metadata[31] = 0x01, 0x0a
+	addr = UserDerivedObjectAddress(owner, metadata)
+	assert.Equal(t, "c6d3d69a9810647845a5ca5ebe905256dc37327c1c39c1d673de00caaac0e3a8", hex.EncodeToString(addr[:]))
+
+	addr = GUIDObjectAddress(source, 4)
+	assert.Equal(t, "fa851c0b6ba90bbf3a7dd317903a3425b693384c2f63f051bbd58fcb979d8231", hex.EncodeToString(addr[:]))
+}
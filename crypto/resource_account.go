@@ -0,0 +1,10 @@
+package crypto
+
+// ResourceAccountAddressScheme is the domain separator of resource account addresses.
+const ResourceAccountAddressScheme byte = 0xFF
+
+// ResourceAccountAddress returns the address of the resource account created by source with seed, as computed
+// by 0x1::account::create_resource_address.
+func ResourceAccountAddress(source, seed []byte) [32]byte {
+	return deriveAddress(ResourceAccountAddressScheme, source, seed)
+}
@@ -0,0 +1,16 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAccountAddress(t *testing.T) {
+	source := make([]byte, 32)
+	source[30], source[31] = 0x0b, 0x0b
+
+	addr := ResourceAccountAddress(source, []byte{0x0b, 0x00, 0x0b})
+	assert.Equal(t, "ee89f8c763c27f9d942d496c1a0dcf32d5eacfe78416f9486b8db66155b163b0", hex.EncodeToString(addr[:]))
+}
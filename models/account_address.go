@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+
+	"github.com/portto/aptos-go-sdk/crypto"
 )
 
 type AccountAddress [32]byte
@@ -44,3 +46,9 @@ func HexToAccountAddress(addr string) (AccountAddress, error) {
 	addrBytes = append(paddingBytes, addrBytes...)
 	return *(*[32]byte)(addrBytes), nil
 }
+
+// PrimaryStoreAddress returns the address of the primary fungible store of owner for the fungible asset of
+// the metadata object. The store may not exist yet.
+func PrimaryStoreAddress(owner, metadata AccountAddress) AccountAddress {
+	return crypto.UserDerivedObjectAddress(owner[:], metadata[:])
+}
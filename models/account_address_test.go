@@ -23,3 +23,13 @@ func TestTodHex(t *testing.T) {
 		assert.Equal(t, addr, accountAddr.ToHex())
 	})
 }
+
+func TestPrimaryStoreAddress(t *testing.T) {
+	owner, err := HexToAccountAddress("0x1")
+	assert.NoError(t, err)
+	metadata, err := HexToAccountAddress("0xa")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "0xc6d3d69a9810647845a5ca5ebe905256dc37327c1c39c1d673de00caaac0e3a8",
+		PrimaryStoreAddress(owner, metadata).ToHex())
+}
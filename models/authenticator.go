@@ -15,6 +15,12 @@ type Signer interface {
 	Sign(tx *Transaction) *Transaction
 }
 
+// AccountSigner is a Signer of the transactions sent by a single account.
+type AccountSigner interface {
+	Signer
+	Address() AccountAddress
+}
+
 type SingleSigner struct {
 	PrivateKey
 	PublicKey
@@ -32,6 +38,10 @@ func NewSingleSigner(priv PrivateKey) SingleSigner {
 	}
 }
 
+func (s *SingleSigner) Address() AccountAddress {
+	return s.AccountAddress
+}
+
 func (s *SingleSigner) Sign(tx *Transaction) *Transaction {
 	if tx.hasError() {
 		return tx
@@ -0,0 +1,170 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/the729/lcs"
+)
+
+// Decoders for the BCS responses of the REST API, which are fetched with the client.WithBCS request option.
+
+// UnmarshalBCS decodes BCS bytes into v, which may be any type with a BCS layout such as a Move resource struct.
+func UnmarshalBCS(data []byte, v interface{}) error {
+	if err := lcs.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("lcs.Unmarshal error: %w", err)
+	}
+	return nil
+}
+
+// DecodeAccountAddressBCS decodes a BCS encoded address.
+func DecodeAccountAddressBCS(data []byte) (AccountAddress, error) {
+	var addr AccountAddress
+	err := UnmarshalBCS(data, &addr)
+	return addr, err
+}
+
+// DecodeRawTransactionBCS decodes a BCS encoded RawTransaction.
+func DecodeRawTransactionBCS(data []byte) (RawTransaction, error) {
+	var tx RawTransaction
+	err := UnmarshalBCS(data, &tx)
+	return tx, err
+}
+
+// DecodeUserTransactionBCS decodes a BCS encoded signed transaction.
+func DecodeUserTransactionBCS(data []byte) (UserTransaction, error) {
+	var tx UserTransaction
+	err := UnmarshalBCS(data, &tx)
+	return tx, err
+}
+
+// GUID is the BCS layout of 0x1::guid::GUID.
+type GUID struct {
+	CreationNum uint64
+	Addr        AccountAddress
+}
+
+// EventHandle is the BCS layout of 0x1::event::EventHandle.
+type EventHandle struct {
+	Counter uint64
+	GUID    GUID
+}
+
+// CapabilityOffer is the BCS layout of 0x1::account::CapabilityOffer.
+type CapabilityOffer struct {
+	For *AccountAddress `lcs:"optional"`
+}
+
+// Account is the BCS layout of 0x1::account::Account, returned by the account endpoint.
+type Account struct {
+	AuthenticationKey       []byte
+	SequenceNumber          uint64
+	GUIDCreationNum         uint64
+	CoinRegisterEvents      EventHandle
+	KeyRotationEvents       EventHandle
+	RotationCapabilityOffer CapabilityOffer
+	SignerCapabilityOffer   CapabilityOffer
+}
+
+// DecodeAccountBCS decodes the BCS response of the account endpoint.
+func DecodeAccountBCS(data []byte) (Account, error) {
+	var account Account
+	err := UnmarshalBCS(data, &account)
+	return account, err
+}
+
+// MoveResource is a resource of the account resources endpoint. Data is the BCS encoded resource, which can be
+// decoded with UnmarshalBCS.
+type MoveResource struct {
+	Type TypeTagStruct
+	Data []byte
+}
+
+// DecodeAccountResourcesBCS decodes the BCS response of the account resources endpoint, a map of the resource
+// types to their data ordered by type.
+func DecodeAccountResourcesBCS(data []byte) ([]MoveResource, error) {
+	var resources []MoveResource
+	err := UnmarshalBCS(data, &resources)
+	return resources, err
+}
+
+// MoveModule is a module of the account modules endpoint.
+type MoveModule struct {
+	ID       Module
+	Bytecode []byte
+}
+
+// DecodeAccountModulesBCS decodes the BCS response of the account modules endpoint, a map of the module IDs to
+// their bytecode ordered by ID.
+func DecodeAccountModulesBCS(data []byte) ([]MoveModule, error) {
+	var modules []MoveModule
+	err := UnmarshalBCS(data, &modules)
+	return modules, err
+}
+
+// DecodeTransactionDataBCS decodes the leading part of the BCS response of the transaction by hash and by version
+// endpoints. It returns the version of a committed transaction, or pending as true for a pending one. Only user
+// transactions are supported; the info, events and changes of a committed transaction are not decoded.
+func DecodeTransactionDataBCS(data []byte) (tx UserTransaction, version uint64, pending bool, err error) {
+	d := lcs.NewDecoder(bytes.NewReader(data))
+
+	var variant uint8
+	if err := d.Decode(&variant); err != nil {
+		return tx, 0, false, fmt.Errorf("decode variant error: %w", err)
+	}
+
+	switch variant {
+	case 0:
+		if err := d.Decode(&version); err != nil {
+			return tx, 0, false, fmt.Errorf("decode version error: %w", err)
+		}
+
+		var enum TransactionEnum = UserTransaction{}
+		if err := d.Decode(&enum); err != nil {
+			return tx, 0, false, fmt.Errorf("decode transaction error: %w", err)
+		}
+		return enum.(UserTransaction), version, false, nil
+	case 1:
+		if err := d.Decode(&tx); err != nil {
+			return tx, 0, false, fmt.Errorf("decode transaction error: %w", err)
+		}
+		if !d.EOF() {
+			return tx, 0, false, fmt.Errorf("unexpected data")
+		}
+		return tx, 0, true, nil
+	default:
+		return tx, 0, false, fmt.Errorf("unexpected transaction data variant: %d", variant)
+	}
+}
+
+// Block is the leading part of the BCS response of the block endpoints.
+type Block struct {
+	BlockHeight    uint64
+	BlockHash      []byte
+	BlockTimestamp uint64
+	FirstVersion   uint64
+	LastVersion    uint64
+
+	// HasTransactions reports whether the response includes the transactions of the block, which are not
+	// decoded; use the transactions endpoints to read them.
+	HasTransactions bool
+}
+
+// DecodeBlockBCS decodes the BCS response of the block by height and by version endpoints.
+func DecodeBlockBCS(data []byte) (Block, error) {
+	var block Block
+	d := lcs.NewDecoder(bytes.NewReader(data))
+	for _, v := range []interface{}{
+		&block.BlockHeight,
+		&block.BlockHash,
+		&block.BlockTimestamp,
+		&block.FirstVersion,
+		&block.LastVersion,
+		&block.HasTransactions,
+	} {
+		if err := d.Decode(v); err != nil {
+			return block, fmt.Errorf("lcs.Decode error: %w", err)
+		}
+	}
+	return block, nil
+}
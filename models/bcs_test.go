@@ -0,0 +1,145 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/the729/lcs"
+)
+
+func TestDecodeBCS(t *testing.T) {
+	addr, err := HexToAccountAddress("0x1")
+	assert.NoError(t, err)
+
+	t.Run("Account", func(t *testing.T) {
+		account := Account{
+			AuthenticationKey: addr[:],
+			SequenceNumber:    7,
+			CoinRegisterEvents: EventHandle{
+				Counter: 1,
+				GUID:    GUID{CreationNum: 0, Addr: addr},
+			},
+			SignerCapabilityOffer: CapabilityOffer{For: &addr},
+		}
+		data, err := lcs.Marshal(account)
+		assert.NoError(t, err)
+
+		decoded, err := DecodeAccountBCS(data)
+		assert.NoError(t, err)
+		assert.Equal(t, account, decoded)
+	})
+
+	t.Run("Resources", func(t *testing.T) {
+		resources := []MoveResource{{
+			Type: TypeTagStruct{Address: addr, Module: "coin", Name: "CoinStore", TypeParams: []TypeTag{}},
+			Data: []byte{1, 2, 3},
+		}}
+		data, err := lcs.Marshal(resources)
+		assert.NoError(t, err)
+
+		decoded, err := DecodeAccountResourcesBCS(data)
+		assert.NoError(t, err)
+		assert.Equal(t, resources, decoded)
+	})
+
+	t.Run("Modules", func(t *testing.T) {
+		// a map of the module IDs to their bytecode: count, then address, name and bytecode of each module
+		data := append([]byte{1}, addr[:]...)
+		data = append(data, 4, 'c', 'o', 'i', 'n', 3, 0xa1, 0x1c, 0xeb)
+
+		decoded, err := DecodeAccountModulesBCS(data)
+		assert.NoError(t, err)
+		assert.Equal(t, []MoveModule{{
+			ID:       Module{Address: addr, Name: "coin"},
+			Bytecode: []byte{0xa1, 0x1c, 0xeb},
+		}}, decoded)
+
+		encoded, err := lcs.Marshal(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, data, encoded)
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		hash := make([]byte, 32)
+		hash[0], hash[31] = 0xaa, 0xbb
+		block := Block{
+			BlockHeight:    5,
+			BlockHash:      hash,
+			BlockTimestamp: 1000,
+			FirstVersion:   10,
+			LastVersion:    12,
+		}
+
+		u64 := func(v uint64) []byte {
+			b, err := lcs.Marshal(v)
+			assert.NoError(t, err)
+			return b
+		}
+		data := u64(block.BlockHeight)
+		// the hash is length prefixed
+		data = append(append(data, 32), hash...)
+		data = append(data, u64(block.BlockTimestamp)...)
+		data = append(data, u64(block.FirstVersion)...)
+		data = append(data, u64(block.LastVersion)...)
+
+		// without transactions, the option is none
+		decoded, err := DecodeBlockBCS(append(data, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, block, decoded)
+
+		// with transactions, the option is some, followed by the undecoded transactions
+		block.HasTransactions = true
+		decoded, err = DecodeBlockBCS(append(data, 1, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, block, decoded)
+
+		_, err = DecodeBlockBCS(data[:20])
+		assert.Error(t, err)
+	})
+
+	t.Run("TransactionData", func(t *testing.T) {
+		tx := UserTransaction{
+			RawTransaction: RawTransaction{
+				Sender:         addr,
+				SequenceNumber: 3,
+				Payload: EntryFunctionPayload{
+					Module:        Module{Address: addr, Name: "coin"},
+					Function:      "transfer",
+					TypeArguments: []TypeTag{},
+					ArgumentsBCS:  [][]byte{},
+				},
+				ChainID: 2,
+			},
+			Authenticator: TransactionAuthenticatorEd25519{
+				PublicKey: make([]byte, 32),
+				Signature: make([]byte, 64),
+			},
+		}
+		var enum TransactionEnum = tx
+		txBytes, err := lcs.Marshal(&enum)
+		assert.NoError(t, err)
+		version, err := lcs.Marshal(uint64(42))
+		assert.NoError(t, err)
+
+		// committed: variant, version, transaction, followed by the undecoded info, events and changes
+		data := append(append(append([]byte{0}, version...), txBytes...), 0xff)
+		decoded, v, pending, err := DecodeTransactionDataBCS(data)
+		assert.NoError(t, err)
+		assert.False(t, pending)
+		assert.Equal(t, uint64(42), v)
+		assert.Equal(t, tx, decoded)
+
+		signed, err := lcs.Marshal(tx)
+		assert.NoError(t, err)
+		decoded, _, pending, err = DecodeTransactionDataBCS(append([]byte{1}, signed...))
+		assert.NoError(t, err)
+		assert.True(t, pending)
+		assert.Equal(t, tx, decoded)
+
+		raw, err := lcs.Marshal(tx.RawTransaction)
+		assert.NoError(t, err)
+		rawTx, err := DecodeRawTransactionBCS(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, tx.RawTransaction, rawTx)
+	})
+}
@@ -0,0 +1,171 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrEventNotRegistered is returned when decoding an event whose type is not registered.
+var ErrEventNotRegistered = errors.New("event type not registered")
+
+type CoinDepositEvent struct {
+	// Account and CoinType are only set by the 0x1::coin::CoinDeposit module event.
+	Account  string `json:"account,omitempty"`
+	CoinType string `json:"coin_type,omitempty"`
+	Amount   Uint64 `json:"amount"`
+}
+
+type CoinWithdrawEvent struct {
+	// Account and CoinType are only set by the 0x1::coin::CoinWithdraw module event.
+	Account  string `json:"account,omitempty"`
+	CoinType string `json:"coin_type,omitempty"`
+	Amount   Uint64 `json:"amount"`
+}
+
+type TokenWithdrawEvent struct {
+	ID     TokenID `json:"id"`
+	Amount Uint64  `json:"amount"`
+}
+
+type TokenMintEvent struct {
+	ID     TokenDataID `json:"id"`
+	Amount Uint64      `json:"amount"`
+}
+
+type TokenBurnEvent struct {
+	ID     TokenID `json:"id"`
+	Amount Uint64  `json:"amount"`
+}
+
+type ObjectTransferEvent struct {
+	Object string `json:"object"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+type FungibleAssetDepositEvent struct {
+	// Store is only set by the 0x1::fungible_asset::Deposit module event.
+	Store  string `json:"store,omitempty"`
+	Amount Uint64 `json:"amount"`
+}
+
+type FungibleAssetWithdrawEvent struct {
+	// Store is only set by the 0x1::fungible_asset::Withdraw module event.
+	Store  string `json:"store,omitempty"`
+	Amount Uint64 `json:"amount"`
+}
+
+type KeyRotationEvent struct {
+	// Account is only set by the 0x1::account::KeyRotation module event.
+	Account              string `json:"account,omitempty"`
+	OldAuthenticationKey string `json:"old_authentication_key"`
+	NewAuthenticationKey string `json:"new_authentication_key"`
+}
+
+// EventRegistry maps Move event types to the Go types their data is decoded into.
+type EventRegistry struct {
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+}
+
+// NewEventRegistry creates an empty EventRegistry.
+func NewEventRegistry() *EventRegistry {
+	return &EventRegistry{types: make(map[string]reflect.Type)}
+}
+
+// DefaultEventRegistry is used by Event.Decode and holds the framework events.
+var DefaultEventRegistry = NewEventRegistry()
+
+func init() {
+	for eventType, template := range map[string]interface{}{
+		"0x1::coin::DepositEvent":            CoinDepositEvent{},
+		"0x1::coin::WithdrawEvent":           CoinWithdrawEvent{},
+		"0x1::coin::CoinDeposit":             CoinDepositEvent{},
+		"0x1::coin::CoinWithdraw":            CoinWithdrawEvent{},
+		"0x3::token::DepositEvent":           TokenDepositEvent{},
+		"0x3::token::WithdrawEvent":          TokenWithdrawEvent{},
+		"0x3::token::MintTokenEvent":         TokenMintEvent{},
+		"0x3::token::BurnTokenEvent":         TokenBurnEvent{},
+		"0x1::object::TransferEvent":         ObjectTransferEvent{},
+		"0x1::object::Transfer":              ObjectTransferEvent{},
+		"0x1::fungible_asset::DepositEvent":  FungibleAssetDepositEvent{},
+		"0x1::fungible_asset::WithdrawEvent": FungibleAssetWithdrawEvent{},
+		"0x1::fungible_asset::Deposit":       FungibleAssetDepositEvent{},
+		"0x1::fungible_asset::Withdraw":      FungibleAssetWithdrawEvent{},
+		"0x1::account::KeyRotationEvent":     KeyRotationEvent{},
+		"0x1::account::KeyRotation":          KeyRotationEvent{},
+	} {
+		if err := DefaultEventRegistry.Register(eventType, template); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Register maps eventType to the Go type of template, replacing any previous mapping. A type without generic
+// parameters, e.g. "0x1::coin::DepositEvent", also matches all its instantiations unless they are registered.
+// It fails if template is nil.
+func (r *EventRegistry) Register(eventType string, template interface{}) error {
+	t := reflect.TypeOf(template)
+	if t == nil {
+		return fmt.Errorf("nil template for event type %s", eventType)
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.types[normalizeEventType(eventType)] = t
+	return nil
+}
+
+// Decode decodes the data of e into a new value of the Go type registered for its type and returns a pointer
+// to it. It fails with ErrEventNotRegistered for unknown types.
+func (r *EventRegistry) Decode(e Event) (interface{}, error) {
+	eventType := normalizeEventType(e.Type)
+
+	r.mu.RLock()
+	t, ok := r.types[eventType]
+	if !ok {
+		if i := strings.Index(eventType, "<"); i >= 0 {
+			t, ok = r.types[eventType[:i]]
+		}
+	}
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEventNotRegistered, e.Type)
+	}
+
+	b, err := json.Marshal(e.Data)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.New(t).Interface()
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, fmt.Errorf("decode %s error: %w", e.Type, err)
+	}
+	return v, nil
+}
+
+// Decode decodes the data of the event with DefaultEventRegistry.
+func (e Event) Decode() (interface{}, error) {
+	return DefaultEventRegistry.Decode(e)
+}
+
+// normalizeEventType rewrites the address of the outer type in its short form, so that "0x1::coin::DepositEvent"
+// and "0x0000000000000000000000000000000000000000000000000000000000000001::coin::DepositEvent" are the same.
+func normalizeEventType(eventType string) string {
+	i := strings.Index(eventType, "::")
+	if i < 0 {
+		return eventType
+	}
+	addr, err := HexToAccountAddress(eventType[:i])
+	if err != nil {
+		return eventType
+	}
+	return addr.PrefixZeroTrimmedHex() + eventType[i:]
+}
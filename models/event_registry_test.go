@@ -0,0 +1,49 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventDecode(t *testing.T) {
+	var events []Event
+	err := json.Unmarshal([]byte(`[
+		{"version":"1","guid":{"creation_number":"2","account_address":"0xa"},"sequence_number":"0","type":"0x1::coin::DepositEvent","data":{"amount":"100"}},
+		{"version":"1","guid":{"creation_number":"0","account_address":"0x0"},"sequence_number":"0","type":"0x3::token::DepositEvent","data":{"id":{"token_data_id":{"creator":"0xb","collection":"c","name":"n"},"property_version":"0"},"amount":"1"}},
+		{"version":"1","guid":{"creation_number":"0","account_address":"0x0"},"sequence_number":"0","type":"0x1::fungible_asset::Deposit","data":{"store":"0xc","amount":"5"}},
+		{"version":"1","guid":{"creation_number":"0","account_address":"0x0"},"sequence_number":"0","type":"0x00000000000000000000000000000000000000000000000000000000000000ab::game::Scored<u64>","data":{"points":"3"}},
+		{"version":"1","guid":{"creation_number":"0","account_address":"0x0"},"sequence_number":"0","type":"0xab::game::Unknown","data":{}}
+	]`), &events)
+	assert.NoError(t, err)
+
+	v, err := events[0].Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, &CoinDepositEvent{Amount: 100}, v)
+
+	v, err = events[1].Decode()
+	assert.NoError(t, err)
+	if deposit, ok := v.(*TokenDepositEvent); assert.True(t, ok) {
+		assert.Equal(t, "n", deposit.ID.Name)
+		assert.Equal(t, "1", deposit.Amount)
+	}
+
+	v, err = events[2].Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, &FungibleAssetDepositEvent{Store: "0xc", Amount: 5}, v)
+
+	type scored struct {
+		Points Uint64 `json:"points"`
+	}
+	registry := NewEventRegistry()
+	assert.NoError(t, registry.Register("0xab::game::Scored", scored{}))
+	assert.Error(t, registry.Register("0xab::game::Missed", nil))
+	v, err = registry.Decode(events[3])
+	assert.NoError(t, err)
+	assert.Equal(t, &scored{Points: 3}, v)
+
+	_, err = registry.Decode(events[4])
+	assert.True(t, errors.Is(err, ErrEventNotRegistered))
+}
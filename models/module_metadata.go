@@ -0,0 +1,134 @@
+package models
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/the729/lcs"
+)
+
+const (
+	moduleMagic        = 0xA11CEB0B
+	metadataTableType  = 0x10
+	metadataV0Key      = "aptos::metadata_v0"
+	metadataV1Key      = "aptos::metadata_v1"
+	maxModuleTableSize = 1 << 24
+)
+
+// ErrorDescription describes an abort reason of a module, from the error map the compiler stores in the module
+// metadata.
+type ErrorDescription struct {
+	CodeName        string
+	CodeDescription string
+}
+
+type errorMapEntry struct {
+	Reason      uint64
+	Description ErrorDescription
+}
+
+// ParseModuleErrorMap extracts the error map, indexed by abort reason, from the metadata of a compiled Move
+// module. It returns an empty map for modules compiled without metadata.
+func ParseModuleErrorMap(bytecode []byte) (map[uint64]ErrorDescription, error) {
+	r := bytes.NewReader(bytecode)
+
+	var magic, version uint32
+	if err := binary.Read(r, binary.BigEndian, &magic); err != nil || magic != moduleMagic {
+		return nil, fmt.Errorf("invalid module magic")
+	}
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return nil, fmt.Errorf("read module version error: %w", err)
+	}
+
+	tableCount, err := readULEB128(r)
+	if err != nil {
+		return nil, fmt.Errorf("read table count error: %w", err)
+	}
+
+	var metadataOffset, metadataSize uint64
+	var found bool
+	for i := uint64(0); i < tableCount; i++ {
+		kind, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("read table kind error: %w", err)
+		}
+		offset, err := readULEB128(r)
+		if err != nil {
+			return nil, fmt.Errorf("read table offset error: %w", err)
+		}
+		size, err := readULEB128(r)
+		if err != nil {
+			return nil, fmt.Errorf("read table size error: %w", err)
+		}
+		if kind == metadataTableType {
+			metadataOffset, metadataSize, found = offset, size, true
+		}
+	}
+
+	errorMap := make(map[uint64]ErrorDescription)
+	if !found {
+		return errorMap, nil
+	}
+
+	// table offsets are relative to the end of the table headers
+	contentStart := uint64(len(bytecode) - r.Len())
+	if metadataSize > maxModuleTableSize || contentStart+metadataOffset+metadataSize > uint64(len(bytecode)) {
+		return nil, fmt.Errorf("invalid metadata table")
+	}
+	table := bytes.NewReader(bytecode[contentStart+metadataOffset : contentStart+metadataOffset+metadataSize])
+
+	for table.Len() > 0 {
+		key, err := readBlob(table)
+		if err != nil {
+			return nil, fmt.Errorf("read metadata key error: %w", err)
+		}
+		value, err := readBlob(table)
+		if err != nil {
+			return nil, fmt.Errorf("read metadata value error: %w", err)
+		}
+		if string(key) != metadataV0Key && string(key) != metadataV1Key {
+			continue
+		}
+
+		// the error map is the first field of both metadata versions
+		var entries []errorMapEntry
+		if err := lcs.NewDecoder(bytes.NewReader(value)).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("decode error map error: %w", err)
+		}
+		for _, entry := range entries {
+			errorMap[entry.Reason] = entry.Description
+		}
+	}
+
+	return errorMap, nil
+}
+
+func readULEB128(r io.ByteReader) (uint64, error) {
+	var v uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid uleb128")
+}
+
+func readBlob(r *bytes.Reader) ([]byte, error) {
+	size, err := readULEB128(r)
+	if err != nil {
+		return nil, err
+	}
+	if size > uint64(r.Len()) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := make([]byte, size)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
@@ -0,0 +1,15 @@
+package models
+
+// ObjectCore is the 0x1::object::ObjectCore resource of an object.
+type ObjectCore struct {
+	GUIDCreationNum Uint64 `json:"guid_creation_num"`
+	Owner           string `json:"owner"`
+	// AllowUngatedTransfer reports whether the owner can transfer the object with 0x1::object::transfer, without
+	// a TransferRef.
+	AllowUngatedTransfer bool `json:"allow_ungated_transfer"`
+}
+
+// OwnerAddress returns Owner as an AccountAddress.
+func (o ObjectCore) OwnerAddress() (AccountAddress, error) {
+	return HexToAccountAddress(o.Owner)
+}
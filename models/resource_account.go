@@ -0,0 +1,10 @@
+package models
+
+import (
+	"github.com/portto/aptos-go-sdk/crypto"
+)
+
+// ResourceAccountAddress returns the address of the resource account created by source with seed.
+func ResourceAccountAddress(source AccountAddress, seed []byte) AccountAddress {
+	return crypto.ResourceAccountAddress(source[:], seed)
+}
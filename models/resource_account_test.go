@@ -0,0 +1,14 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAccount(t *testing.T) {
+	source, err := HexToAccountAddress("0xb0b")
+	assert.NoError(t, err)
+	assert.Equal(t, "0xee89f8c763c27f9d942d496c1a0dcf32d5eacfe78416f9486b8db66155b163b0",
+		ResourceAccountAddress(source, []byte{0x0b, 0x00, 0x0b}).ToHex())
+}
@@ -164,32 +164,9 @@ func (t *Transaction) SetPayload(payload TransactionPayload) *Transaction {
 			payload.TypeArguments = make([]TypeTag, 0)
 		}
 
-		payload.ArgumentsBCS = make([][]byte, len(payload.Arguments))
-		for i, arg := range payload.Arguments {
-			switch arg := arg.(type) {
-			case AccountAddress:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case [32]byte:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case []byte:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case string:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case uint64:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case uint8:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case bool:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case []bool:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			case []string:
-				payload.ArgumentsBCS[i], t.err = lcs.Marshal(&arg)
-			}
-			if t.err != nil {
-				t.err = fmt.Errorf("marshal arguments[%d] %v: %v", i, arg, t.err)
-				return t
-			}
+		payload.ArgumentsBCS, t.err = marshalArguments(payload.Arguments)
+		if t.err != nil {
+			return t
 		}
 		t.Payload = payload
 	default:
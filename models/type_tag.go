@@ -81,3 +81,36 @@ func (t TypeTagStruct) ToString() string {
 
 	return fmt.Sprintf("%s<%s>", structType, strings.Join(types, ","))
 }
+
+// MoveTypeString returns the type in Move syntax as used by the REST API, e.g. "u64" or
+// "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>".
+func MoveTypeString(t TypeTag) string {
+	switch t := t.(type) {
+	case TypeTagBool:
+		return "bool"
+	case TypeTagU8:
+		return "u8"
+	case TypeTagU64:
+		return "u64"
+	case TypeTagU128:
+		return "u128"
+	case TypeTagAddress:
+		return "address"
+	case TypeTagSigner:
+		return "signer"
+	case TypeTagVector:
+		return fmt.Sprintf("vector<%s>", MoveTypeString(t.TypeTag))
+	case TypeTagStruct:
+		structType := fmt.Sprintf("%s::%s::%s", t.Address.PrefixZeroTrimmedHex(), t.Module, t.Name)
+		if len(t.TypeParams) == 0 {
+			return structType
+		}
+
+		types := make([]string, len(t.TypeParams))
+		for i, p := range t.TypeParams {
+			types[i] = MoveTypeString(p)
+		}
+		return fmt.Sprintf("%s<%s>", structType, strings.Join(types, ", "))
+	}
+	return t.ToString()
+}
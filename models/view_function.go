@@ -0,0 +1,101 @@
+package models
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/the729/lcs"
+)
+
+// ViewFunction is the BCS request body of a view function call.
+type ViewFunction struct {
+	Module
+	Function      string
+	TypeArguments []TypeTag
+	ArgumentsBCS  [][]byte
+	Arguments     []interface{} `lcs:"-"`
+}
+
+// EncodeArguments sets ArgumentsBCS from Arguments, which take the same Go types as the arguments of an
+// EntryFunctionPayload.
+func (f ViewFunction) EncodeArguments() (ViewFunction, error) {
+	if f.TypeArguments == nil {
+		f.TypeArguments = make([]TypeTag, 0)
+	}
+
+	var err error
+	f.ArgumentsBCS, err = marshalArguments(f.Arguments)
+	return f, err
+}
+
+// JSONArguments returns Arguments in the JSON form of the REST API: addresses and byte vectors as hex strings
+// and 64-bit integers as decimal strings.
+func (f ViewFunction) JSONArguments() []interface{} {
+	args := make([]interface{}, len(f.Arguments))
+	for i, arg := range f.Arguments {
+		switch arg := arg.(type) {
+		case AccountAddress:
+			args[i] = arg.ToHex()
+		case [32]byte:
+			args[i] = AccountAddress(arg).ToHex()
+		case []byte:
+			args[i] = "0x" + hex.EncodeToString(arg)
+		case uint64:
+			args[i] = strconv.FormatUint(arg, 10)
+		case []uint64:
+			values := make([]string, len(arg))
+			for j, v := range arg {
+				values[j] = strconv.FormatUint(v, 10)
+			}
+			args[i] = values
+		case []AccountAddress:
+			addrs := make([]string, len(arg))
+			for j, addr := range arg {
+				addrs[j] = addr.ToHex()
+			}
+			args[i] = addrs
+		default:
+			args[i] = arg
+		}
+	}
+	return args
+}
+
+// marshalArguments BCS encodes the arguments of an entry or view function.
+func marshalArguments(args []interface{}) ([][]byte, error) {
+	argsBCS := make([][]byte, len(args))
+	for i, arg := range args {
+		var err error
+		switch arg := arg.(type) {
+		case AccountAddress:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case [32]byte:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case []byte:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case [][]byte:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case string:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case uint64:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case uint8:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case bool:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case []bool:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case []string:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case []uint64:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		case []AccountAddress:
+			argsBCS[i], err = lcs.Marshal(&arg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("marshal arguments[%d] %v: %v", i, arg, err)
+		}
+	}
+	return argsBCS, nil
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type VMStatusKind string
+
+const (
+	VMStatusExecuted         VMStatusKind = "executed"
+	VMStatusMoveAbort        VMStatusKind = "move_abort"
+	VMStatusExecutionFailure VMStatusKind = "execution_failure"
+	VMStatusOutOfGas         VMStatusKind = "out_of_gas"
+	VMStatusMiscellaneous    VMStatusKind = "miscellaneous_error"
+)
+
+// Abort categories of the std::error module, carried by the highest bits of an abort code.
+var abortCategories = map[uint8]string{
+	0x1: "INVALID_ARGUMENT",
+	0x2: "OUT_OF_RANGE",
+	0x3: "INVALID_STATE",
+	0x4: "UNAUTHENTICATED",
+	0x5: "PERMISSION_DENIED",
+	0x6: "NOT_FOUND",
+	0x7: "ABORTED",
+	0x8: "ALREADY_EXISTS",
+	0x9: "RESOURCE_EXHAUSTED",
+	0xA: "CANCELLED",
+	0xB: "INTERNAL",
+	0xC: "NOT_IMPLEMENTED",
+	0xD: "UNAVAILABLE",
+}
+
+// VMStatus is the structured form of the vm_status of a transaction.
+type VMStatus struct {
+	Raw  string
+	Kind VMStatusKind
+
+	// Location is the module of a Move abort or of an execution failure, e.g. "0x1::coin", or "script".
+	Location      string
+	ModuleAddress string
+	ModuleName    string
+	// Function is the function of an execution failure.
+	Function string
+
+	// AbortCode is the code of a Move abort. For the codes following the std::error convention, Category
+	// is its upper bits and Reason its lower 16 bits.
+	AbortCode   uint64
+	Category    uint8
+	Reason      uint64
+	ReasonName  string
+	Description string
+}
+
+// CategoryName returns the name of the std::error category of the abort code, or an empty string if unknown.
+func (s VMStatus) CategoryName() string {
+	return abortCategories[s.Category]
+}
+
+func (s VMStatus) Success() bool {
+	return s.Kind == VMStatusExecuted
+}
+
+var (
+	moveAbortRegexp        = regexp.MustCompile(`^Move abort in ([^:\s]+(?:::\w+)?): (?:(\w+)\((0x[0-9a-fA-F]+)\)|(0x[0-9a-fA-F]+|\d+))(?:: (.*))?$`)
+	executionFailureRegexp = regexp.MustCompile(`^Execution failed in ([^:\s]+::\w+)::(\w+) at code offset \d+`)
+)
+
+// ParseVMStatus parses a vm_status such as "Move abort in 0x1::coin: EINSUFFICIENT_BALANCE(0x10006): Not enough
+// coins to complete transaction". Unknown formats are returned as VMStatusMiscellaneous with only Raw set.
+func ParseVMStatus(status string) VMStatus {
+	s := VMStatus{Raw: status, Kind: VMStatusMiscellaneous}
+
+	switch {
+	case status == "Executed successfully":
+		s.Kind = VMStatusExecuted
+	case strings.HasPrefix(status, "Out of gas"):
+		s.Kind = VMStatusOutOfGas
+	case moveAbortRegexp.MatchString(status):
+		m := moveAbortRegexp.FindStringSubmatch(status)
+		code := m[3]
+		if code == "" {
+			code = m[4]
+		}
+		abortCode, err := strconv.ParseUint(code, 0, 64)
+		if err != nil {
+			return s
+		}
+
+		s.Kind = VMStatusMoveAbort
+		s.setLocation(m[1])
+		s.SetAbortCode(abortCode)
+		s.ReasonName = m[2]
+		s.Description = m[5]
+	case executionFailureRegexp.MatchString(status):
+		m := executionFailureRegexp.FindStringSubmatch(status)
+		s.Kind = VMStatusExecutionFailure
+		s.setLocation(m[1])
+		s.Function = m[2]
+	}
+
+	return s
+}
+
+// SetAbortCode sets AbortCode along with its Category and Reason.
+func (s *VMStatus) SetAbortCode(code uint64) {
+	s.AbortCode = code
+	s.Category = uint8(code >> 16)
+	s.Reason = code & 0xffff
+}
+
+func (s *VMStatus) setLocation(location string) {
+	s.Location = location
+	if parts := strings.SplitN(location, "::", 2); len(parts) == 2 {
+		s.ModuleAddress = parts[0]
+		s.ModuleName = parts[1]
+	}
+}
+
+func (s VMStatus) String() string {
+	if s.Kind != VMStatusMoveAbort {
+		return s.Raw
+	}
+
+	str := fmt.Sprintf("Move abort in %s: ", s.Location)
+	if s.ReasonName != "" {
+		str += fmt.Sprintf("%s(%#x)", s.ReasonName, s.AbortCode)
+	} else {
+		str += fmt.Sprintf("%#x", s.AbortCode)
+	}
+	if s.Description != "" {
+		str += ": " + s.Description
+	}
+	return str
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/the729/lcs"
+)
+
+func TestParseVMStatus(t *testing.T) {
+	s := ParseVMStatus("Move abort in 0x1::coin: EINSUFFICIENT_BALANCE(0x10006): Not enough coins to complete transaction")
+	assert.Equal(t, VMStatusMoveAbort, s.Kind)
+	assert.Equal(t, "0x1", s.ModuleAddress)
+	assert.Equal(t, "coin", s.ModuleName)
+	assert.Equal(t, uint64(0x10006), s.AbortCode)
+	assert.Equal(t, uint8(1), s.Category)
+	assert.Equal(t, "INVALID_ARGUMENT", s.CategoryName())
+	assert.Equal(t, uint64(6), s.Reason)
+	assert.Equal(t, "EINSUFFICIENT_BALANCE", s.ReasonName)
+	assert.Equal(t, "Not enough coins to complete transaction", s.Description)
+	assert.Equal(t, s.Raw, s.String())
+
+	s = ParseVMStatus("Move abort in 0x3::token: 0x60005")
+	assert.Equal(t, VMStatusMoveAbort, s.Kind)
+	assert.Equal(t, "token", s.ModuleName)
+	assert.Equal(t, "NOT_FOUND", s.CategoryName())
+	assert.Equal(t, uint64(5), s.Reason)
+	assert.Empty(t, s.ReasonName)
+
+	s = ParseVMStatus("Execution failed in 0x1::coin::transfer at code offset 12")
+	assert.Equal(t, VMStatusExecutionFailure, s.Kind)
+	assert.Equal(t, "0x1::coin", s.Location)
+	assert.Equal(t, "transfer", s.Function)
+
+	assert.True(t, ParseVMStatus("Executed successfully").Success())
+	assert.Equal(t, VMStatusOutOfGas, ParseVMStatus("Out of gas").Kind)
+	assert.Equal(t, VMStatusMiscellaneous, ParseVMStatus("Transaction Executed and Committed with Error LINKER_ERROR").Kind)
+}
+
+func TestParseModuleErrorMap(t *testing.T) {
+	value, err := lcs.Marshal([]errorMapEntry{
+		{Reason: 6, Description: ErrorDescription{CodeName: "EINSUFFICIENT_BALANCE", CodeDescription: "Not enough coins"}},
+	})
+	assert.NoError(t, err)
+	// struct and function attribute maps of metadata v1
+	value = append(value, 0, 0)
+
+	var table []byte
+	for _, blob := range [][]byte{[]byte("other"), {1}, []byte(metadataV1Key), value} {
+		table = append(append(table, byte(len(blob))), blob...)
+	}
+
+	// magic, version 6, one identifiers table of 3 bytes and the metadata table
+	bytecode := []byte{0xa1, 0x1c, 0xeb, 0x0b, 6, 0, 0, 0, 2, 0x07, 0, 3, metadataTableType, 3, byte(len(table))}
+	bytecode = append(append(append(bytecode, "abc"...), table...), 0)
+
+	errorMap, err := ParseModuleErrorMap(bytecode)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64]ErrorDescription{
+		6: {CodeName: "EINSUFFICIENT_BALANCE", CodeDescription: "Not enough coins"},
+	}, errorMap)
+
+	_, err = ParseModuleErrorMap([]byte{1, 2, 3, 4})
+	assert.Error(t, err)
+}
@@ -0,0 +1,207 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	ChangeTypeWriteResource   = "write_resource"
+	ChangeTypeDeleteResource  = "delete_resource"
+	ChangeTypeWriteModule     = "write_module"
+	ChangeTypeDeleteModule    = "delete_module"
+	ChangeTypeWriteTableItem  = "write_table_item"
+	ChangeTypeDeleteTableItem = "delete_table_item"
+)
+
+// WriteSetChange is one of the typed changes returned by DecodeChange: *WriteResourceChange,
+// *DeleteResourceChange, *WriteModuleChange, *DeleteModuleChange, *WriteTableItemChange or
+// *DeleteTableItemChange.
+type WriteSetChange interface {
+	ChangeType() string
+}
+
+type WriteResourceChange struct {
+	Address      string `json:"address"`
+	StateKeyHash string `json:"state_key_hash"`
+	Data         struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	} `json:"data"`
+}
+
+// DecodeData decodes the new value of the resource into v.
+func (c *WriteResourceChange) DecodeData(v interface{}) error {
+	return json.Unmarshal(c.Data.Data, v)
+}
+
+type DeleteResourceChange struct {
+	Address      string `json:"address"`
+	StateKeyHash string `json:"state_key_hash"`
+	Resource     string `json:"resource"`
+}
+
+type WriteModuleChange struct {
+	Address      string `json:"address"`
+	StateKeyHash string `json:"state_key_hash"`
+	Data         struct {
+		Bytecode string          `json:"bytecode"`
+		ABI      json.RawMessage `json:"abi"`
+	} `json:"data"`
+}
+
+type DeleteModuleChange struct {
+	Address      string `json:"address"`
+	StateKeyHash string `json:"state_key_hash"`
+	Module       string `json:"module"`
+}
+
+// WriteTableItemChange is a table item write. Key and Value are hex encoded BCS; Data holds their decoded
+// form when the node could resolve the types of the table.
+type WriteTableItemChange struct {
+	StateKeyHash string `json:"state_key_hash"`
+	Handle       string `json:"handle"`
+	Key          string `json:"key"`
+	Value        string `json:"value"`
+	Data         *struct {
+		Key       json.RawMessage `json:"key"`
+		KeyType   string          `json:"key_type"`
+		Value     json.RawMessage `json:"value"`
+		ValueType string          `json:"value_type"`
+	} `json:"data"`
+}
+
+// DecodeValue decodes the new value of the item into v. It fails if the node did not decode the value.
+func (c *WriteTableItemChange) DecodeValue(v interface{}) error {
+	if c.Data == nil {
+		return fmt.Errorf("table item value of handle %s is not decoded", c.Handle)
+	}
+	return json.Unmarshal(c.Data.Value, v)
+}
+
+type DeleteTableItemChange struct {
+	StateKeyHash string `json:"state_key_hash"`
+	Handle       string `json:"handle"`
+	Key          string `json:"key"`
+	Data         *struct {
+		Key     json.RawMessage `json:"key"`
+		KeyType string          `json:"key_type"`
+	} `json:"data"`
+}
+
+func (*WriteResourceChange) ChangeType() string {
+	return ChangeTypeWriteResource
+}
+
+func (*DeleteResourceChange) ChangeType() string {
+	return ChangeTypeDeleteResource
+}
+
+func (*WriteModuleChange) ChangeType() string {
+	return ChangeTypeWriteModule
+}
+
+func (*DeleteModuleChange) ChangeType() string {
+	return ChangeTypeDeleteModule
+}
+
+func (*WriteTableItemChange) ChangeType() string {
+	return ChangeTypeWriteTableItem
+}
+
+func (*DeleteTableItemChange) ChangeType() string {
+	return ChangeTypeDeleteTableItem
+}
+
+// DecodeChange decodes the JSON of a write set change into its typed variant according to its type field.
+func DecodeChange(data []byte) (WriteSetChange, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	var change WriteSetChange
+	switch header.Type {
+	case ChangeTypeWriteResource:
+		change = &WriteResourceChange{}
+	case ChangeTypeDeleteResource:
+		change = &DeleteResourceChange{}
+	case ChangeTypeWriteModule:
+		change = &WriteModuleChange{}
+	case ChangeTypeDeleteModule:
+		change = &DeleteModuleChange{}
+	case ChangeTypeWriteTableItem:
+		change = &WriteTableItemChange{}
+	case ChangeTypeDeleteTableItem:
+		change = &DeleteTableItemChange{}
+	default:
+		return nil, fmt.Errorf("unknown change type %q", header.Type)
+	}
+
+	if err := json.Unmarshal(data, change); err != nil {
+		return nil, fmt.Errorf("decode %s error: %w", header.Type, err)
+	}
+	return change, nil
+}
+
+// WriteSetChanges is a list of write set changes decoded into their typed variants.
+type WriteSetChanges []WriteSetChange
+
+func (c *WriteSetChanges) UnmarshalJSON(b []byte) error {
+	var changes []json.RawMessage
+	if err := json.Unmarshal(b, &changes); err != nil {
+		return err
+	}
+
+	variants := make(WriteSetChanges, len(changes))
+	for i, change := range changes {
+		var err error
+		if variants[i], err = DecodeChange(change); err != nil {
+			return fmt.Errorf("changes[%d]: %w", i, err)
+		}
+	}
+	*c = variants
+	return nil
+}
+
+// FilterResourceChanges returns the resource writes and deletions of changes at address, in any address form,
+// and of resourceType. A resource type without generic parameters, e.g. "0x1::coin::CoinStore", matches all its
+// instantiations. An empty address or resourceType matches any.
+func FilterResourceChanges(changes []WriteSetChange, address, resourceType string) []WriteSetChange {
+	var filtered []WriteSetChange
+	for _, change := range changes {
+		var changeAddress, changeType string
+		switch change := change.(type) {
+		case *WriteResourceChange:
+			changeAddress, changeType = change.Address, change.Data.Type
+		case *DeleteResourceChange:
+			changeAddress, changeType = change.Address, change.Resource
+		default:
+			continue
+		}
+
+		if address != "" && !sameAddress(address, changeAddress) {
+			continue
+		}
+		if resourceType != "" && changeType != resourceType && !strings.HasPrefix(changeType, resourceType+"<") {
+			continue
+		}
+		filtered = append(filtered, change)
+	}
+	return filtered
+}
+
+func sameAddress(a, b string) bool {
+	addrA, err := HexToAccountAddress(a)
+	if err != nil {
+		return false
+	}
+	addrB, err := HexToAccountAddress(b)
+	if err != nil {
+		return false
+	}
+	return addrA == addrB
+}
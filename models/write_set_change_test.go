@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteSetChanges(t *testing.T) {
+	var variants WriteSetChanges
+	err := json.Unmarshal([]byte(`[
+		{"type":"write_resource","address":"0x0a","state_key_hash":"0x1","data":{"type":"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>","data":{"coin":{"value":"100"},"frozen":false}}},
+		{"type":"write_resource","address":"0xb","state_key_hash":"0x2","data":{"type":"0x1::account::Account","data":{"sequence_number":"4"}}},
+		{"type":"delete_resource","address":"0xa","state_key_hash":"0x3","resource":"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>"},
+		{"type":"write_table_item","state_key_hash":"0x4","handle":"0x5","key":"0x06","value":"0x07","data":null},
+		{"type":"write_module","address":"0xa","state_key_hash":"0x8","data":{"bytecode":"0xa11ceb0b","abi":{}}}
+	]`), &variants)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(variants))
+	assert.Equal(t, ChangeTypeWriteTableItem, variants[3].ChangeType())
+	assert.Error(t, variants[3].(*WriteTableItemChange).DecodeValue(&struct{}{}))
+	assert.Equal(t, "0xa11ceb0b", variants[4].(*WriteModuleChange).Data.Bytecode)
+
+	filtered := FilterResourceChanges(variants, "0x000a", "0x1::coin::CoinStore")
+	assert.Equal(t, 2, len(filtered))
+	if write, ok := filtered[0].(*WriteResourceChange); assert.True(t, ok) {
+		var store struct {
+			Coin struct {
+				Value Uint64 `json:"value"`
+			} `json:"coin"`
+		}
+		assert.NoError(t, write.DecodeData(&store))
+		assert.Equal(t, Uint64(100), store.Coin.Value)
+	}
+	assert.Equal(t, ChangeTypeDeleteResource, filtered[1].ChangeType())
+
+	assert.Equal(t, 3, len(FilterResourceChanges(variants, "", "")))
+	assert.Equal(t, 0, len(FilterResourceChanges(variants, "0xa", "0x1::coin::Coin")))
+
+	err = json.Unmarshal([]byte(`[{"type":"unknown"}]`), &variants)
+	assert.Error(t, err)
+}